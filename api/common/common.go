@@ -1,6 +1,9 @@
 package common
 
 import (
+	"errors"
+
+	"github.com/nknorg/nkn/chain"
 	"github.com/nknorg/nkn/node"
 	"github.com/nknorg/nkn/vault"
 )
@@ -36,3 +39,26 @@ func ResponsePack(errCode ErrCode) map[string]interface{} {
 	}
 	return resp
 }
+
+// getStateReader returns the states at the optional "height" param, or the
+// current states if height is not given.
+func getStateReader(params map[string]interface{}) (chain.IStateReader, ErrCode, error) {
+	if _, ok := params["height"]; !ok {
+		return chain.DefaultLedger.Store, SUCCESS, nil
+	}
+
+	height, ok := params["height"].(float64)
+	if !ok {
+		return nil, INVALID_PARAMS, errors.New("height should be a float64")
+	}
+
+	states, err := chain.DefaultLedger.Store.GetStateReaderAtHeight(uint32(height))
+	if err == chain.ErrStatePruned {
+		return nil, ErrStatePruned, err
+	}
+	if err != nil {
+		return nil, INVALID_PARAMS, err
+	}
+
+	return states, SUCCESS, nil
+}
@@ -7,9 +7,13 @@ const (
 	SESSION_EXPIRED          ErrCode = 41001
 	SERVICE_CEILING          ErrCode = 41002
 	ILLEGAL_DATAFORMAT       ErrCode = 41003
+	RATE_LIMITED             ErrCode = 41004
+	PAYLOAD_TOO_LARGE        ErrCode = 41005
 	INVALID_METHOD           ErrCode = 42001
 	INVALID_PARAMS           ErrCode = 42002
 	INVALID_TOKEN            ErrCode = 42003
+	INVALID_SIGNATURE        ErrCode = 42004
+	ACCESS_DENIED            ErrCode = 42005
 	INVALID_TRANSACTION      ErrCode = 43001
 	INVALID_ASSET            ErrCode = 43002
 	INVALID_BLOCK            ErrCode = 43003
@@ -47,6 +51,11 @@ const (
 	ErrAppendTxnPool         ErrCode = 45021
 	ErrNullID                ErrCode = 45022
 	ErrZeroID                ErrCode = 45023
+	ErrStatePruned           ErrCode = 45024
+	ErrReplaceUnderpriced    ErrCode = 45025
+	ErrFeeRateTooLow         ErrCode = 45026
+	ErrHistoryUnavailable    ErrCode = 45027
+	ErrIndexDisabled         ErrCode = 45028
 )
 
 var ErrMessage = map[ErrCode]string{
@@ -54,9 +63,13 @@ var ErrMessage = map[ErrCode]string{
 	SESSION_EXPIRED:         "SESSION EXPIRED",
 	SERVICE_CEILING:         "SERVICE CEILING",
 	ILLEGAL_DATAFORMAT:      "ILLEGAL DATAFORMAT",
+	RATE_LIMITED:            "RATE LIMIT EXCEEDED",
+	PAYLOAD_TOO_LARGE:       "PAYLOAD TOO LARGE",
 	INVALID_METHOD:          "INVALID METHOD",
 	INVALID_PARAMS:          "INVALID PARAMS",
 	INVALID_TOKEN:           "VERIFY TOKEN ERROR",
+	INVALID_SIGNATURE:       "INVALID SIGNATURE",
+	ACCESS_DENIED:           "ACCESS DENIED",
 	INVALID_TRANSACTION:     "INVALID TRANSACTION",
 	INVALID_ASSET:           "INVALID ASSET",
 	INVALID_BLOCK:           "INVALID BLOCK",
@@ -84,4 +97,9 @@ var ErrMessage = map[ErrCode]string{
 	ErrAppendTxnPool:        "INTERNAL ERROR, can not append tx to txpool",
 	ErrNullID:               "INTERNAL ERROR, there is no ID in account",
 	ErrZeroID:               "INTERNAL ERROR, it's zero ID in account",
+	ErrStatePruned:          "STATE PRUNED, states at this height have been pruned",
+	ErrReplaceUnderpriced:   "REPLACEMENT UNDERPRICED, replacement transaction fee is too low",
+	ErrFeeRateTooLow:        "FEE RATE TOO LOW, transaction fee per byte is lower than txpool minimum",
+	ErrHistoryUnavailable:   "HISTORY UNAVAILABLE, history at this height is not available on this node",
+	ErrIndexDisabled:        "INDEX DISABLED, the requested index is not enabled on this node",
 }
@@ -31,6 +31,27 @@ func MakeTransferTransaction(wallet vault.Wallet, receipt Uint160, nonce uint64,
 	return txn, nil
 }
 
+func MakeTransferAssetTransaction(wallet vault.Wallet, receipt Uint160, assetID Uint256, nonce uint64, value, fee Fixed64) (*transaction.Transaction, error) {
+	account, err := wallet.GetDefaultAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// construct transaction
+	txn, err := transaction.NewTransferAssetWithIDTransaction(account.ProgramHash, receipt, assetID, nonce, value, fee)
+	if err != nil {
+		return nil, err
+	}
+
+	// sign transaction contract
+	err = wallet.Sign(txn)
+	if err != nil {
+		return nil, err
+	}
+
+	return txn, nil
+}
+
 func MakeSigChainTransaction(wallet vault.Wallet, sigChain []byte, nonce uint64) (*transaction.Transaction, error) {
 	account, err := wallet.GetDefaultAccount()
 	if err != nil {
@@ -70,6 +91,26 @@ func MakeRegisterNameTransaction(wallet vault.Wallet, name string, nonce uint64,
 	return txn, nil
 }
 
+func MakeTransferNameTransaction(wallet vault.Wallet, name string, recipient []byte, nonce uint64, fee Fixed64) (*transaction.Transaction, error) {
+	account, err := wallet.GetDefaultAccount()
+	if err != nil {
+		return nil, err
+	}
+	registrant := account.PubKey().EncodePoint()
+	txn, err := transaction.NewTransferNameTransaction(registrant, recipient, name, nonce, fee)
+	if err != nil {
+		return nil, err
+	}
+
+	// sign transaction contract
+	err = wallet.Sign(txn)
+	if err != nil {
+		return nil, err
+	}
+
+	return txn, nil
+}
+
 func MakeDeleteNameTransaction(wallet vault.Wallet, name string, nonce uint64, fee Fixed64) (*transaction.Transaction, error) {
 	account, err := wallet.GetDefaultAccount()
 	if err != nil {
@@ -7,8 +7,11 @@ import (
 	"net"
 	"strings"
 
+	"github.com/nknorg/nkn/api/websocket/messagebuffer"
 	"github.com/nknorg/nkn/block"
 	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/chain/pool"
+	"github.com/nknorg/nkn/chain/store"
 	"github.com/nknorg/nkn/common"
 	"github.com/nknorg/nkn/crypto"
 	"github.com/nknorg/nkn/node"
@@ -22,6 +25,7 @@ import (
 const (
 	BIT_JSONRPC   byte = 1
 	BIT_WEBSOCKET byte = 2
+	BIT_LOCALHOST byte = 4 // only accessable from localhost
 )
 
 type Handler func(Serverer, map[string]interface{}) map[string]interface{}
@@ -51,6 +55,16 @@ func (ah *APIHandler) IsAccessableByWebsocket() bool {
 	return true
 }
 
+// IsLocalhostOnly return true if the handler is
+// only able to be invoked from localhost
+func (ah *APIHandler) IsLocalhostOnly() bool {
+	if ah.AccessCtrl&BIT_LOCALHOST != BIT_LOCALHOST {
+		return false
+	}
+
+	return true
+}
+
 // getLatestBlockHash gets the latest block hash
 // params: {}
 // return: {"resultOrData":<result>|<error data>, "error":<errcode>}
@@ -79,7 +93,9 @@ func getBlock(s Serverer, params map[string]interface{}) map[string]interface{}
 	if index, ok := params["height"].(float64); ok {
 		var err error
 		height := uint32(index)
-		if hash, err = chain.DefaultLedger.Store.GetBlockHash(height); err != nil {
+		if hash, err = chain.DefaultLedger.Store.GetBlockHash(height); err == chain.ErrHistoryUnavailable {
+			return respPacking(ErrHistoryUnavailable, err.Error())
+		} else if err != nil {
 			return respPacking(UNKNOWN_HASH, err.Error())
 		}
 	} else if str, ok := params["hash"].(string); ok {
@@ -169,6 +185,9 @@ func getBlockTxsByHeight(s Serverer, params map[string]interface{}) map[string]i
 	}
 	index := uint32(params["height"].(float64))
 	hash, err := chain.DefaultLedger.Store.GetBlockHash(index)
+	if err == chain.ErrHistoryUnavailable {
+		return respPacking(ErrHistoryUnavailable, err.Error())
+	}
 	if err != nil {
 		return respPacking(UNKNOWN_HASH, err.Error())
 	}
@@ -304,6 +323,185 @@ func getTransaction(s Serverer, params map[string]interface{}) map[string]interf
 	return respPacking(SUCCESS, tran)
 }
 
+// getTransactionProof gets the header of the block containing a transaction
+// and the merkle branch of the transaction to the block transactions root
+// params: {"hash":<hash>}
+// return: {"resultOrData":<result>|<error data>, "error":<errcode>}
+func getTransactionProof(s Serverer, params map[string]interface{}) map[string]interface{} {
+	if len(params) < 1 {
+		return respPacking(INVALID_PARAMS, "length of params is less than 1")
+	}
+
+	str, ok := params["hash"].(string)
+	if !ok {
+		return respPacking(INVALID_PARAMS, "hash should be a string")
+	}
+
+	hex, err := common.HexStringToBytes(str)
+	if err != nil {
+		return respPacking(INVALID_PARAMS, err.Error())
+	}
+	var hash common.Uint256
+	err = hash.Deserialize(bytes.NewReader(hex))
+	if err != nil {
+		return respPacking(INVALID_PARAMS, err.Error())
+	}
+
+	header, index, branch, err := chain.DefaultLedger.Store.GetTransactionProof(hash)
+	if err != nil {
+		return respPacking(UNKNOWN_TRANSACTION, err.Error())
+	}
+
+	info, err := header.GetInfo()
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+	var headerInfo interface{}
+	json.Unmarshal(info, &headerInfo)
+
+	branchHex := make([]string, 0, len(branch))
+	for _, h := range branch {
+		branchHex = append(branchHex, h.ToHexString())
+	}
+
+	return respPacking(SUCCESS, map[string]interface{}{
+		"header": headerInfo,
+		"index":  index,
+		"branch": branchHex,
+	})
+}
+
+// getTransactionStatus gets the lifecycle status of a transaction submitted to
+// this node. Transactions that are not tracked are looked up in txpool and
+// ledger.
+// params: {"hash":<hash>}
+// return: {"resultOrData":<result>|<error data>, "error":<errcode>}
+func getTransactionStatus(s Serverer, params map[string]interface{}) map[string]interface{} {
+	if len(params) < 1 {
+		return respPacking(INVALID_PARAMS, "length of params is less than 1")
+	}
+
+	str, ok := params["hash"].(string)
+	if !ok {
+		return respPacking(INVALID_PARAMS, "hash should be a string")
+	}
+
+	hex, err := common.HexStringToBytes(str)
+	if err != nil {
+		return respPacking(INVALID_PARAMS, err.Error())
+	}
+	var hash common.Uint256
+	err = hash.Deserialize(bytes.NewReader(hex))
+	if err != nil {
+		return respPacking(INVALID_PARAMS, err.Error())
+	}
+
+	localNode, err := s.GetNetNode()
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	history := localNode.GetTxnPool().GetTxnStatus(hash)
+	if len(history) == 0 {
+		if height, err := chain.DefaultLedger.Store.GetTransactionHeight(hash); err == nil {
+			history = []*pool.TxnStatusUpdate{{
+				TxnHash: hash.ToHexString(),
+				Status:  pool.TxnStatusConfirmed,
+				Height:  height,
+			}}
+		} else if localNode.GetTxnPool().GetTxnByHash(hash) != nil {
+			history = []*pool.TxnStatusUpdate{{
+				TxnHash: hash.ToHexString(),
+				Status:  pool.TxnStatusPending,
+			}}
+		} else {
+			return respPacking(UNKNOWN_TRANSACTION, "transaction status not found")
+		}
+	}
+
+	latest := history[len(history)-1]
+
+	return respPacking(SUCCESS, map[string]interface{}{
+		"txHash":     latest.TxnHash,
+		"status":     latest.Status,
+		"reason":     latest.Reason,
+		"replacedBy": latest.ReplacedBy,
+		"height":     latest.Height,
+		"history":    history,
+	})
+}
+
+// getTransactionsByAddr gets transactions involving an address, newest first.
+// Returns ErrIndexDisabled if address transaction index is not enabled, and
+// ErrHistoryUnavailable if the page reaches blocks before the height from which
+// address transaction index is complete.
+// params: {"address":<address>, "offset":<offset>, "limit":<limit>}
+// return: {"resultOrData":<result>|<error data>, "error":<errcode>}
+func getTransactionsByAddr(s Serverer, params map[string]interface{}) map[string]interface{} {
+	if len(params) < 1 {
+		return respPacking(INVALID_PARAMS, "length of params is less than 1")
+	}
+
+	if !config.Parameters.AddressTxIndex {
+		return respPacking(ErrIndexDisabled, "address transaction index is not enabled")
+	}
+
+	addr, ok := params["address"].(string)
+	if !ok {
+		return respPacking(INVALID_PARAMS, "address should be a string")
+	}
+
+	pg, err := common.ToScriptHash(addr)
+	if err != nil {
+		return respPacking(INVALID_PARAMS, err.Error())
+	}
+
+	var offset float64
+	if _, ok := params["offset"]; ok {
+		offset, ok = params["offset"].(float64)
+		if !ok {
+			return respPacking(INVALID_PARAMS, "offset should be a float64")
+		}
+	}
+
+	var limit float64
+	if _, ok := params["limit"]; ok {
+		limit, ok = params["limit"].(float64)
+		if !ok {
+			return respPacking(INVALID_PARAMS, "limit should be a float64")
+		}
+	} else {
+		limit = 100
+	}
+
+	hashes, err := chain.DefaultLedger.Store.GetTransactionsByAddr(pg, uint32(offset), uint32(limit))
+	if err == chain.ErrHistoryUnavailable {
+		return respPacking(ErrHistoryUnavailable, err.Error())
+	}
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	txs := make([]interface{}, 0, len(hashes))
+	for _, hash := range hashes {
+		tx, err := chain.DefaultLedger.Store.GetTransaction(hash)
+		if err != nil {
+			return respPacking(UNKNOWN_TRANSACTION, err.Error())
+		}
+
+		info, err := tx.GetInfo()
+		if err != nil {
+			return respPacking(INTERNAL_ERROR, err.Error())
+		}
+
+		var tran interface{}
+		json.Unmarshal(info, &tran)
+		txs = append(txs, tran)
+	}
+
+	return respPacking(SUCCESS, txs)
+}
+
 // sendRawTransaction  sends raw transaction to the block chain
 // params: {"tx":<transaction>}
 // return: {"resultOrData":<result>|<error data>, "error":<errcode>}
@@ -363,6 +561,124 @@ func getNodeState(s Serverer, params map[string]interface{}) map[string]interfac
 	return respPacking(SUCCESS, localNode)
 }
 
+// getMessageBufferStats gets the statistics of offline client message buffer
+// params: {}
+// return: {"resultOrData":<result>|<error data>, "error":<errcode>}
+func getMessageBufferStats(s Serverer, params map[string]interface{}) map[string]interface{} {
+	if messagebuffer.DefaultMessageBuffer == nil {
+		return respPacking(INTERNAL_ERROR, "message buffer is not initialized")
+	}
+
+	return respPacking(SUCCESS, messagebuffer.DefaultMessageBuffer.GetStats())
+}
+
+// getRelayUsage gets the current relay usage of clients by public key and ip.
+// Only accessible from localhost since it exposes client addresses.
+// params: {}
+// return: {"resultOrData":<result>|<error data>, "error":<errcode>}
+func getRelayUsage(s Serverer, params map[string]interface{}) map[string]interface{} {
+	localNode, err := s.GetNetNode()
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	return respPacking(SUCCESS, localNode.GetRelayUsage())
+}
+
+// estimateFee estimates fee for a transaction to be included in next few blocks
+// params: {"blocks":<number of recent blocks to look at>, "size":<transaction size in bytes>}
+// return: {"resultOrData":<result>|<error data>, "error":<errcode>}
+func estimateFee(s Serverer, params map[string]interface{}) map[string]interface{} {
+	numBlocks := pool.DefaultFeeEstimateBlocks
+	if v, ok := params["blocks"].(float64); ok {
+		if v < 1 || v > pool.MaxFeeEstimateBlocks {
+			return respPacking(INVALID_PARAMS, "blocks out of range")
+		}
+		numBlocks = int(v)
+	}
+
+	var txnSize uint32
+	if v, ok := params["size"].(float64); ok {
+		if v < 0 || v > float64(config.MaxBlockSize) {
+			return respPacking(INVALID_PARAMS, "invalid size")
+		}
+		txnSize = uint32(v)
+	}
+
+	localNode, err := s.GetNetNode()
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	result, err := localNode.GetTxnPool().EstimateFee(numBlocks, txnSize)
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	return respPacking(SUCCESS, result)
+}
+
+// getTrace gets the relay trace of a traced message known by this node
+// params: {"messageId":<message id hex>}
+// return: {"resultOrData":<result>|<error data>, "error":<errcode>}
+func getTrace(s Serverer, params map[string]interface{}) map[string]interface{} {
+	if len(params) < 1 {
+		return respPacking(INVALID_PARAMS, "length of params is less than 1")
+	}
+
+	str, ok := params["messageId"].(string)
+	if !ok {
+		return respPacking(INVALID_PARAMS, "messageId should be a string")
+	}
+
+	messageID, err := hex.DecodeString(str)
+	if err != nil || len(messageID) == 0 {
+		return respPacking(INVALID_PARAMS, "invalid messageId")
+	}
+
+	localNode, err := s.GetNetNode()
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	trace := localNode.GetRelayTrace(messageID)
+	if trace == nil {
+		return respPacking(UNKNOWN_HASH, "trace not found")
+	}
+
+	return respPacking(SUCCESS, trace)
+}
+
+// reloadAccessList reloads client and destination access list from file
+// params: {}
+// return: {"resultOrData":<result>|<error data>, "error":<errcode>}
+func reloadAccessList(s Serverer, params map[string]interface{}) map[string]interface{} {
+	localNode, err := s.GetNetNode()
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	err = localNode.ReloadAccessList()
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	return respPacking(SUCCESS, localNode.GetAccessListStats())
+}
+
+// getAccessListStats gets the number of rules and rejected traffic of access
+// list
+// params: {}
+// return: {"resultOrData":<result>|<error data>, "error":<errcode>}
+func getAccessListStats(s Serverer, params map[string]interface{}) map[string]interface{} {
+	localNode, err := s.GetNetNode()
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	return respPacking(SUCCESS, localNode.GetAccessListStats())
+}
+
 // setDebugInfo sets log level
 // params: {"level":<log leverl>}
 // return: {"resultOrData":<result>|<error data>, "error":<errcode>}
@@ -425,7 +741,7 @@ func getWsAddr(s Serverer, params map[string]interface{}) map[string]interface{}
 }
 
 // getBalanceByAddr gets balance by address
-// params: {"address":<address>}
+// params: {"address":<address>, "height":<height>}
 // return: {"resultOrData":<result>|<error data>, "error":<errcode>}
 func getBalanceByAddr(s Serverer, params map[string]interface{}) map[string]interface{} {
 	if len(params) < 1 {
@@ -442,7 +758,12 @@ func getBalanceByAddr(s Serverer, params map[string]interface{}) map[string]inte
 		return respPacking(INTERNAL_ERROR, err.Error())
 	}
 
-	value := chain.DefaultLedger.Store.GetBalance(pg)
+	states, errCode, err := getStateReader(params)
+	if err != nil {
+		return respPacking(errCode, err.Error())
+	}
+
+	value := states.GetBalance(pg)
 
 	ret := map[string]interface{}{
 		"amount": value.String(),
@@ -452,7 +773,7 @@ func getBalanceByAddr(s Serverer, params map[string]interface{}) map[string]inte
 }
 
 // getBalanceByAssetID gets balance by address
-// params: {"address":<address>, "assetid":<assetid>}
+// params: {"address":<address>, "assetid":<assetid>, "height":<height>}
 // return: {"resultOrData":<result>|<error data>, "error":<errcode>}
 func GetBalanceByAssetID(s Serverer, params map[string]interface{}) map[string]interface{} {
 	if len(params) < 2 {
@@ -484,8 +805,13 @@ func GetBalanceByAssetID(s Serverer, params map[string]interface{}) map[string]i
 		return respPacking(INTERNAL_ERROR, err.Error())
 	}
 
-	value := chain.DefaultLedger.Store.GetBalanceByAssetID(pg, assetID)
-	_, symbol, _, _, err := chain.DefaultLedger.Store.GetAsset(assetID)
+	states, errCode, err := getStateReader(params)
+	if err != nil {
+		return respPacking(errCode, err.Error())
+	}
+
+	value := states.GetBalanceByAssetID(pg, assetID)
+	_, symbol, _, _, err := states.GetAsset(assetID)
 	if err != nil {
 		return respPacking(INTERNAL_ERROR, err.Error())
 	}
@@ -499,8 +825,9 @@ func GetBalanceByAssetID(s Serverer, params map[string]interface{}) map[string]i
 	return respPacking(SUCCESS, ret)
 }
 
-// getNonceByAddr gets balance by address
-// params: {"address":<address>}
+// getNonceByAddr gets balance by address. Nonce in txpool is only returned
+// when height is not given.
+// params: {"address":<address>, "height":<height>}
 // return: {"resultOrData":<result>|<error data>, "error":<errcode>}
 func getNonceByAddr(s Serverer, params map[string]interface{}) map[string]interface{} {
 	if len(params) < 1 {
@@ -522,6 +849,18 @@ func getNonceByAddr(s Serverer, params map[string]interface{}) map[string]interf
 		return respPacking(INTERNAL_ERROR, err.Error())
 	}
 
+	if _, ok := params["height"]; ok {
+		states, errCode, err := getStateReader(params)
+		if err != nil {
+			return respPacking(errCode, err.Error())
+		}
+
+		return respPacking(SUCCESS, map[string]interface{}{
+			"nonce":         states.GetNonce(pg),
+			"currentHeight": uint32(params["height"].(float64)),
+		})
+	}
+
 	persistNonce := chain.DefaultLedger.Store.GetNonce(pg)
 
 	txpool := localNode.GetTxnPool()
@@ -540,7 +879,7 @@ func getNonceByAddr(s Serverer, params map[string]interface{}) map[string]interf
 }
 
 // getId gets id by publick key
-// params: {"publickey":<publickey>}
+// params: {"publickey":<publickey>, "height":<height>}
 // return: {"resultOrData":<result>|<error data>, "error":<errcode>}
 func getId(s Serverer, params map[string]interface{}) map[string]interface{} {
 	if len(params) < 1 {
@@ -557,7 +896,12 @@ func getId(s Serverer, params map[string]interface{}) map[string]interface{} {
 		return respPacking(INVALID_PARAMS, err.Error())
 	}
 
-	id, err := chain.DefaultLedger.Store.GetID(pkSlice)
+	states, errCode, err := getStateReader(params)
+	if err != nil {
+		return respPacking(errCode, err.Error())
+	}
+
+	id, err := states.GetID(pkSlice)
 	if err != nil {
 		return respPacking(INVALID_PARAMS, err.Error())
 	}
@@ -578,13 +922,21 @@ func getId(s Serverer, params map[string]interface{}) map[string]interface{} {
 }
 
 func VerifyAndSendTx(localNode *node.LocalNode, txn *transaction.Transaction) (ErrCode, error) {
-	if err := localNode.AppendTxnPool(txn); err != nil {
+	if err := localNode.AppendLocalTxn(txn); err != nil {
 		log.Warningf("Add transaction to TxnPool error: %v", err)
 
 		if err == chain.ErrIDRegistered || err == chain.ErrDuplicateGenerateIDTxn {
 			return ErrDuplicatedTx, err
 		}
 
+		if err == pool.ErrReplaceUnderpriced {
+			return ErrReplaceUnderpriced, err
+		}
+
+		if err == pool.ErrFeeRateTooLow {
+			return ErrFeeRateTooLow, err
+		}
+
 		return ErrAppendTxnPool, err
 	}
 	if err := localNode.BroadcastTransaction(txn); err != nil {
@@ -596,7 +948,7 @@ func VerifyAndSendTx(localNode *node.LocalNode, txn *transaction.Transaction) (E
 }
 
 // getAddressByName get address by name
-// params: {"name":<name>}
+// params: {"name":<name>, "height":<height>}
 // return: {"resultOrData":<result>|<error data>, "error":<errcode>}
 func getAddressByName(s Serverer, params map[string]interface{}) map[string]interface{} {
 	if len(params) < 1 {
@@ -608,7 +960,12 @@ func getAddressByName(s Serverer, params map[string]interface{}) map[string]inte
 		return respPacking(INVALID_PARAMS, "name should be a string")
 	}
 
-	publicKey, err := chain.DefaultLedger.Store.GetRegistrant(name)
+	states, errCode, err := getStateReader(params)
+	if err != nil {
+		return respPacking(errCode, err.Error())
+	}
+
+	publicKey, err := states.GetRegistrant(name)
 	if err != nil {
 		return respPacking(INTERNAL_ERROR, err.Error())
 	}
@@ -635,7 +992,7 @@ func getAddressByName(s Serverer, params map[string]interface{}) map[string]inte
 }
 
 // getSubscription get subscription
-// params: {"topic":<topic>, "bucket":<bucket>, "subscriber":<subscriber>}
+// params: {"topic":<topic>, "bucket":<bucket>, "subscriber":<subscriber>, "height":<height>}
 // return: {"resultOrData":<result>|<error data>, "error":<errcode>}
 func getSubscription(s Serverer, params map[string]interface{}) map[string]interface{} {
 	if len(params) < 2 {
@@ -665,7 +1022,12 @@ func getSubscription(s Serverer, params map[string]interface{}) map[string]inter
 		return respPacking(INTERNAL_ERROR, err.Error())
 	}
 
-	meta, expiresAt, err := chain.DefaultLedger.Store.GetSubscription(topic, uint32(bucket), pubKey, identifier)
+	states, errCode, err := getStateReader(params)
+	if err != nil {
+		return respPacking(errCode, err.Error())
+	}
+
+	meta, expiresAt, err := states.GetSubscription(topic, uint32(bucket), pubKey, identifier)
 	if err != nil {
 		return respPacking(INTERNAL_ERROR, err.Error())
 	}
@@ -678,8 +1040,9 @@ func getSubscription(s Serverer, params map[string]interface{}) map[string]inter
 	})
 }
 
-// getSubscribers get subscribers by topic
-// params: {"topic":<topic>, "bucket":<bucket>, "offset":<offset>, "limit":<limit>, "meta":<meta>, "txPool":<txPool>}
+// getSubscribers get subscribers by topic. Subscribers in txpool are only
+// returned when height is not given.
+// params: {"topic":<topic>, "bucket":<bucket>, "offset":<offset>, "limit":<limit>, "meta":<meta>, "txPool":<txPool>, "height":<height>}
 // return: {"resultOrData":<result>|<error data>, "error":<errcode>}
 func getSubscribers(s Serverer, params map[string]interface{}) map[string]interface{} {
 	if len(params) < 2 {
@@ -718,6 +1081,9 @@ func getSubscribers(s Serverer, params map[string]interface{}) map[string]interf
 	}
 
 	txPool, _ := params["txPool"].(bool)
+	if _, ok := params["height"]; ok {
+		txPool = false
+	}
 
 	localNode, err := s.GetNetNode()
 	if err != nil {
@@ -726,12 +1092,17 @@ func getSubscribers(s Serverer, params map[string]interface{}) map[string]interf
 
 	response := make(map[string]interface{})
 
+	states, errCode, err := getStateReader(params)
+	if err != nil {
+		return respPacking(errCode, err.Error())
+	}
+
 	meta, _ := params["meta"].(bool)
 	var subscribers interface{}
 	if !meta {
-		subscribers, err = chain.DefaultLedger.Store.GetSubscribers(topic, uint32(bucket), uint32(offset), uint32(limit))
+		subscribers, err = states.GetSubscribers(topic, uint32(bucket), uint32(offset), uint32(limit))
 	} else {
-		subscribers, err = chain.DefaultLedger.Store.GetSubscribersWithMeta(topic, uint32(bucket), uint32(offset), uint32(limit))
+		subscribers, err = states.GetSubscribersWithMeta(topic, uint32(bucket), uint32(offset), uint32(limit))
 	}
 	if err != nil {
 		return respPacking(INTERNAL_ERROR, err.Error())
@@ -750,7 +1121,7 @@ func getSubscribers(s Serverer, params map[string]interface{}) map[string]interf
 }
 
 // getSubscribersCount get subscribers count by topic
-// params: {"topic":<topic>, "bucket":<bucket>}
+// params: {"topic":<topic>, "bucket":<bucket>, "height":<height>}
 // return: {"resultOrData":<result>|<error data>, "error":<errcode>}
 func getSubscribersCount(s Serverer, params map[string]interface{}) map[string]interface{} {
 	if len(params) < 1 {
@@ -770,12 +1141,17 @@ func getSubscribersCount(s Serverer, params map[string]interface{}) map[string]i
 		}
 	}
 
-	count := chain.DefaultLedger.Store.GetSubscribersCount(topic, uint32(bucket))
+	states, errCode, err := getStateReader(params)
+	if err != nil {
+		return respPacking(errCode, err.Error())
+	}
+
+	count := states.GetSubscribersCount(topic, uint32(bucket))
 	return respPacking(SUCCESS, count)
 }
 
 // getAsset get subscribers by topic
-// params: {"assetid":<id>}
+// params: {"assetid":<id>, "height":<height>}
 // return: {"resultOrData":<result>|<error data>, "error":<errcode>}
 func getAsset(s Serverer, params map[string]interface{}) map[string]interface{} {
 	if len(params) < 1 {
@@ -797,7 +1173,12 @@ func getAsset(s Serverer, params map[string]interface{}) map[string]interface{}
 		return respPacking(INVALID_PARAMS, err.Error())
 	}
 
-	name, symbol, totalSupply, precision, err := chain.DefaultLedger.Store.GetAsset(assetID)
+	states, errCode, err := getStateReader(params)
+	if err != nil {
+		return respPacking(errCode, err.Error())
+	}
+
+	name, symbol, totalSupply, precision, err := states.GetAsset(assetID)
 	if err != nil {
 		return respPacking(INTERNAL_ERROR, err.Error())
 	}
@@ -812,6 +1193,86 @@ func getAsset(s Serverer, params map[string]interface{}) map[string]interface{}
 	return respPacking(SUCCESS, ret)
 }
 
+// getProof gets an account, name or subscription state value with its merkle
+// proof against the state root of the block at height
+// params: {"address":<address>} | {"name":<name>} |
+// {"topic":<topic>, "bucket":<bucket>, "subscriber":<subscriber>}, "height":<height>
+// return: {"resultOrData":<result>|<error data>, "error":<errcode>}
+func getProof(s Serverer, params map[string]interface{}) map[string]interface{} {
+	if len(params) < 1 {
+		return respPacking(INVALID_PARAMS, "length of params is less than 1")
+	}
+
+	var key []byte
+	if addr, ok := params["address"].(string); ok {
+		pg, err := common.ToScriptHash(addr)
+		if err != nil {
+			return respPacking(INVALID_PARAMS, err.Error())
+		}
+		key = store.AccountStateKey(pg)
+	} else if name, ok := params["name"].(string); ok {
+		key = store.NameStateKey(name)
+	} else if topic, ok := params["topic"].(string); ok {
+		var bucket float64
+		if _, ok := params["bucket"]; ok {
+			bucket, ok = params["bucket"].(float64)
+			if !ok {
+				return respPacking(INVALID_PARAMS, "bucket should be a float64")
+			}
+		}
+
+		subscriber, ok := params["subscriber"].(string)
+		if !ok {
+			return respPacking(INVALID_PARAMS, "subscriber should be a string")
+		}
+
+		_, pubKey, identifier, err := address.ParseClientAddress(subscriber)
+		if err != nil {
+			return respPacking(INVALID_PARAMS, err.Error())
+		}
+		key = store.PubSubStateKey(topic, uint32(bucket), pubKey, identifier)
+	} else {
+		return respPacking(INVALID_PARAMS, "one of address, name or topic is required")
+	}
+
+	currentHeight := chain.DefaultLedger.Store.GetHeight()
+	height := currentHeight
+	if _, ok := params["height"]; ok {
+		h, ok := params["height"].(float64)
+		if !ok {
+			return respPacking(INVALID_PARAMS, "height should be a float64")
+		}
+		height = uint32(h)
+	}
+	if height > currentHeight {
+		return respPacking(INVALID_PARAMS, "height is higher than current height")
+	}
+
+	root, value, proof, err := chain.DefaultLedger.Store.GetStateProof(key, height)
+	if err == chain.ErrStatePruned {
+		return respPacking(ErrStatePruned, err.Error())
+	}
+	if err == chain.ErrHistoryUnavailable {
+		return respPacking(ErrHistoryUnavailable, err.Error())
+	}
+	if err != nil {
+		return respPacking(INTERNAL_ERROR, err.Error())
+	}
+
+	proofHex := make([]string, 0, len(proof))
+	for _, p := range proof {
+		proofHex = append(proofHex, hex.EncodeToString(p))
+	}
+
+	return respPacking(SUCCESS, map[string]interface{}{
+		"height":    height,
+		"stateRoot": root.ToHexString(),
+		"key":       hex.EncodeToString(key),
+		"value":     hex.EncodeToString(value),
+		"proof":     proofHex,
+	})
+}
+
 // getMyExtIP get RPC client's external IP
 // params: {"address":<address>}
 // return: {"resultOrData":<result>|<error data>, "error":<errcode>}
@@ -906,31 +1367,41 @@ func findSuccessorAddr(s Serverer, params map[string]interface{}) map[string]int
 }
 
 var InitialAPIHandlers = map[string]APIHandler{
-	"getlatestblockhash":   {Handler: getLatestBlockHash, AccessCtrl: BIT_JSONRPC},
-	"getblock":             {Handler: getBlock, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
-	"getblockcount":        {Handler: getBlockCount, AccessCtrl: BIT_JSONRPC},
-	"getlatestblockheight": {Handler: getLatestBlockHeight, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
-	"getblocktxsbyheight":  {Handler: getBlockTxsByHeight, AccessCtrl: BIT_JSONRPC},
-	"getconnectioncount":   {Handler: getConnectionCount, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
-	"getrawmempool":        {Handler: getRawMemPool, AccessCtrl: BIT_JSONRPC},
-	"gettransaction":       {Handler: getTransaction, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
-	"sendrawtransaction":   {Handler: sendRawTransaction, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
-	"getwsaddr":            {Handler: getWsAddr, AccessCtrl: BIT_JSONRPC},
-	"getversion":           {Handler: getVersion, AccessCtrl: BIT_JSONRPC},
-	"getneighbor":          {Handler: getNeighbor, AccessCtrl: BIT_JSONRPC},
-	"getnodestate":         {Handler: getNodeState, AccessCtrl: BIT_JSONRPC},
-	"getchordringinfo":     {Handler: getChordRingInfo, AccessCtrl: BIT_JSONRPC},
-	"setdebuginfo":         {Handler: setDebugInfo},
-	"getbalancebyaddr":     {Handler: getBalanceByAddr, AccessCtrl: BIT_JSONRPC},
-	"getbalancebyassetid":  {Handler: GetBalanceByAssetID, AccessCtrl: BIT_JSONRPC},
-	"getnoncebyaddr":       {Handler: getNonceByAddr, AccessCtrl: BIT_JSONRPC},
-	"getid":                {Handler: getId, AccessCtrl: BIT_JSONRPC},
-	"getaddressbyname":     {Handler: getAddressByName, AccessCtrl: BIT_JSONRPC},
-	"getsubscription":      {Handler: getSubscription, AccessCtrl: BIT_JSONRPC},
-	"getsubscribers":       {Handler: getSubscribers, AccessCtrl: BIT_JSONRPC},
-	"getsubscriberscount":  {Handler: getSubscribersCount, AccessCtrl: BIT_JSONRPC},
-	"getasset":             {Handler: getAsset, AccessCtrl: BIT_JSONRPC},
-	"getmyextip":           {Handler: getMyExtIP, AccessCtrl: BIT_JSONRPC},
-	"findsuccessoraddr":    {Handler: findSuccessorAddr, AccessCtrl: BIT_JSONRPC},
-	"findsuccessoraddrs":   {Handler: findSuccessorAddrs, AccessCtrl: BIT_JSONRPC},
+	"getlatestblockhash":    {Handler: getLatestBlockHash, AccessCtrl: BIT_JSONRPC},
+	"getblock":              {Handler: getBlock, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
+	"getblockcount":         {Handler: getBlockCount, AccessCtrl: BIT_JSONRPC},
+	"getlatestblockheight":  {Handler: getLatestBlockHeight, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
+	"getblocktxsbyheight":   {Handler: getBlockTxsByHeight, AccessCtrl: BIT_JSONRPC},
+	"getconnectioncount":    {Handler: getConnectionCount, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
+	"getrawmempool":         {Handler: getRawMemPool, AccessCtrl: BIT_JSONRPC},
+	"gettransaction":        {Handler: getTransaction, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
+	"gettransactionproof":   {Handler: getTransactionProof, AccessCtrl: BIT_JSONRPC},
+	"gettransactionstatus":  {Handler: getTransactionStatus, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
+	"gettransactionsbyaddr": {Handler: getTransactionsByAddr, AccessCtrl: BIT_JSONRPC},
+	"sendrawtransaction":    {Handler: sendRawTransaction, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
+	"getwsaddr":             {Handler: getWsAddr, AccessCtrl: BIT_JSONRPC},
+	"getversion":            {Handler: getVersion, AccessCtrl: BIT_JSONRPC},
+	"getneighbor":           {Handler: getNeighbor, AccessCtrl: BIT_JSONRPC},
+	"getnodestate":          {Handler: getNodeState, AccessCtrl: BIT_JSONRPC},
+	"getchordringinfo":      {Handler: getChordRingInfo, AccessCtrl: BIT_JSONRPC},
+	"setdebuginfo":          {Handler: setDebugInfo},
+	"getbalancebyaddr":      {Handler: getBalanceByAddr, AccessCtrl: BIT_JSONRPC},
+	"getbalancebyassetid":   {Handler: GetBalanceByAssetID, AccessCtrl: BIT_JSONRPC},
+	"getnoncebyaddr":        {Handler: getNonceByAddr, AccessCtrl: BIT_JSONRPC},
+	"getid":                 {Handler: getId, AccessCtrl: BIT_JSONRPC},
+	"getaddressbyname":      {Handler: getAddressByName, AccessCtrl: BIT_JSONRPC},
+	"getsubscription":       {Handler: getSubscription, AccessCtrl: BIT_JSONRPC},
+	"getsubscribers":        {Handler: getSubscribers, AccessCtrl: BIT_JSONRPC},
+	"getsubscriberscount":   {Handler: getSubscribersCount, AccessCtrl: BIT_JSONRPC},
+	"getasset":              {Handler: getAsset, AccessCtrl: BIT_JSONRPC},
+	"getproof":              {Handler: getProof, AccessCtrl: BIT_JSONRPC},
+	"getmyextip":            {Handler: getMyExtIP, AccessCtrl: BIT_JSONRPC},
+	"findsuccessoraddr":     {Handler: findSuccessorAddr, AccessCtrl: BIT_JSONRPC},
+	"findsuccessoraddrs":    {Handler: findSuccessorAddrs, AccessCtrl: BIT_JSONRPC},
+	"getmessagebufferstats": {Handler: getMessageBufferStats, AccessCtrl: BIT_JSONRPC},
+	"getrelayusage":         {Handler: getRelayUsage, AccessCtrl: BIT_JSONRPC | BIT_LOCALHOST},
+	"gettrace":              {Handler: getTrace, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
+	"estimatefee":           {Handler: estimateFee, AccessCtrl: BIT_JSONRPC | BIT_WEBSOCKET},
+	"reloadaccesslist":      {Handler: reloadAccessList, AccessCtrl: BIT_JSONRPC | BIT_LOCALHOST},
+	"getaccessliststats":    {Handler: getAccessListStats, AccessCtrl: BIT_JSONRPC | BIT_LOCALHOST},
 }
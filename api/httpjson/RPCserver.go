@@ -37,6 +37,9 @@ type ServeMux struct {
 	//collection of Handlers
 	m map[string]common.Handler
 
+	//collection of Handlers that can only be called from localhost
+	localhostOnly map[string]bool
+
 	//will be called when the request of rpc client contains no implemented functions.
 	defaultFunction func(http.ResponseWriter, *http.Request)
 }
@@ -45,7 +48,8 @@ type ServeMux struct {
 func NewServer(localNode *node.LocalNode, wallet vault.Wallet) *RPCServer {
 	server := &RPCServer{
 		mainMux: ServeMux{
-			m: make(map[string]common.Handler),
+			m:             make(map[string]common.Handler),
+			localhostOnly: make(map[string]bool),
 		},
 		listeners: []string{":" + strconv.Itoa(int(config.Parameters.HttpJsonPort))},
 		localNode: localNode,
@@ -124,7 +128,23 @@ func (s *RPCServer) Handle(w http.ResponseWriter, r *http.Request) {
 
 		//get the corresponding function
 		function, ok := s.mainMux.m[method]
-		if ok {
+		if ok && s.mainMux.localhostOnly[method] && !isLocalhost(r.RemoteAddr) {
+			log.Warningf("HTTP JSON RPC Handle - Reject %s from %s", method, r.RemoteAddr)
+			errcode := common.ACCESS_DENIED
+			data, err := json.Marshal(map[string]interface{}{
+				"jsonrpc": "2.0",
+				"error": map[string]interface{}{
+					"code":    -errcode,
+					"message": common.ErrMessage[errcode],
+				},
+				"id": id,
+			})
+			if err != nil {
+				log.Error("HTTP JSON RPC Handle - json.Marshal: ", err)
+				return
+			}
+			w.Write(data)
+		} else if ok {
 			var data []byte
 			var err error
 			response := function(s, params)
@@ -209,6 +229,9 @@ func (s *RPCServer) Start() {
 	for name, handler := range common.InitialAPIHandlers {
 		if handler.IsAccessableByJsonrpc() {
 			s.HandleFunc(name, handler.Handler)
+			if handler.IsLocalhostOnly() {
+				s.mainMux.localhostOnly[name] = true
+			}
 		}
 	}
 
@@ -239,6 +262,15 @@ func (s *RPCServer) Start() {
 	httpServer.Serve(listener)
 }
 
+func isLocalhost(remoteAddr string) bool {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
 func (s *RPCServer) GetNetNode() (*node.LocalNode, error) {
 	return s.localNode, nil
 }
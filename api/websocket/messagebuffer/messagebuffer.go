@@ -1,42 +1,385 @@
 package messagebuffer
 
 import (
+	"container/list"
+	"encoding/binary"
 	"encoding/hex"
+	"errors"
+	"sort"
 	"sync"
+	"time"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/nknorg/nkn/chain/db"
 	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/util/log"
 )
 
-// MessageBuffer is the buffer to hold message for clients not online
+const (
+	cleanupInterval = time.Minute
+)
+
+// DefaultMessageBuffer is the message buffer used by websocket server
+var DefaultMessageBuffer *MessageBuffer
+
+// Stats is the statistics of message buffer
+type Stats struct {
+	NumClients   int    `json:"numClients"`
+	NumSources   int    `json:"numSources"`
+	NumMessages  int    `json:"numMessages"`
+	TotalSize    int    `json:"totalSize"`
+	NumAdded     uint64 `json:"numAdded"`
+	NumPopped    uint64 `json:"numPopped"`
+	NumExpired   uint64 `json:"numExpired"`
+	NumEvicted   uint64 `json:"numEvicted"`
+	NumRejected  uint64 `json:"numRejected"`
+	IsPersistent bool   `json:"isPersistent"`
+}
+
+type bufferedMessage struct {
+	clientID      []byte
+	source        string
+	seq           uint64
+	msg           *pb.Relay
+	size          int
+	expiresAt     time.Time
+	clientElement *list.Element
+	sourceElement *list.Element
+	globalElement *list.Element
+}
+
+// clientBuffer holds messages of the same client or from the same source
+type clientBuffer struct {
+	messages *list.List
+	size     int
+}
+
+// MessageBuffer is the buffer to hold message for clients not online. Messages
+// are dropped after MaxHoldingSeconds, and oldest messages are evicted first
+// when per client, per source or total limit is reached. Per source limit
+// prevents a single sender from evicting messages of all other senders by
+// sending to many different clients.
 type MessageBuffer struct {
 	sync.Mutex
-	buffer map[string][]*pb.Relay
+	buffer              map[string]*clientBuffer
+	sources             map[string]*clientBuffer
+	messages            *list.List // all messages in the order of being added
+	totalSize           int
+	nextSeq             uint64
+	maxMsgsPerClient    int
+	maxSizePerClient    int
+	maxSizePerSource    int
+	maxTotalSize        int
+	store               db.IStore
+	numAdded, numPopped uint64
+	numExpired          uint64
+	numEvicted          uint64
+	numRejected         uint64
 }
 
-// NewMessageBuffer creates a MessageBuffer
-func NewMessageBuffer() *MessageBuffer {
-	return &MessageBuffer{
-		buffer: make(map[string][]*pb.Relay),
+// NewMessageBuffer creates a MessageBuffer using limits in config. If
+// MsgBufferPersistent is true, buffered messages are saved to db and loaded
+// when created.
+func NewMessageBuffer() (*MessageBuffer, error) {
+	messageBuffer := &MessageBuffer{
+		buffer:           make(map[string]*clientBuffer),
+		sources:          make(map[string]*clientBuffer),
+		messages:         list.New(),
+		maxMsgsPerClient: int(config.Parameters.MsgBufferMaxMsgsPerClient),
+		maxSizePerClient: int(config.Parameters.MsgBufferMaxSizePerClient),
+		maxSizePerSource: int(config.Parameters.MsgBufferMaxSizePerSource),
+		maxTotalSize:     int(config.Parameters.MsgBufferMaxTotalSize) * 1024 * 1024,
 	}
+
+	if config.Parameters.MsgBufferPersistent {
+		store, err := db.NewLevelDBStore(config.Parameters.MsgBufferDBPath)
+		if err != nil {
+			return nil, err
+		}
+		messageBuffer.store = store
+
+		err = messageBuffer.load()
+		if err != nil {
+			store.Close()
+			return nil, err
+		}
+	}
+
+	go messageBuffer.cleanup()
+
+	return messageBuffer, nil
 }
 
-// AddMessage adds a message to message buffer
-func (messageBuffer *MessageBuffer) AddMessage(clientID []byte, msg *pb.Relay) {
+// AddMessage adds a message to message buffer, returns if the message is
+// buffered.
+func (messageBuffer *MessageBuffer) AddMessage(clientID []byte, msg *pb.Relay) bool {
 	if msg.MaxHoldingSeconds == 0 {
-		return
+		return false
 	}
-	clientIDStr := hex.EncodeToString(clientID)
+
 	messageBuffer.Lock()
 	defer messageBuffer.Unlock()
-	messageBuffer.buffer[clientIDStr] = append(messageBuffer.buffer[clientIDStr], msg)
+
+	m := &bufferedMessage{
+		clientID:  clientID,
+		source:    hex.EncodeToString(msg.SrcPubkey),
+		seq:       messageBuffer.nextSeq,
+		msg:       msg,
+		size:      msg.Size(),
+		expiresAt: time.Now().Add(time.Duration(msg.MaxHoldingSeconds) * time.Second),
+	}
+
+	if !messageBuffer.canHold(m) {
+		messageBuffer.numRejected++
+		return false
+	}
+
+	if messageBuffer.store != nil {
+		value, err := encodeMessage(m)
+		if err == nil {
+			err = messageBuffer.store.Put(db.MessageBufferKey(m.clientID, m.seq), value)
+		}
+		if err != nil {
+			log.Errorf("Save buffered message error: %v", err)
+		}
+	}
+
+	messageBuffer.nextSeq++
+	messageBuffer.add(m)
+	messageBuffer.numAdded++
+
+	return true
 }
 
-// PopMessages reads and clears all messages of a client
+// PopMessages reads and clears all messages of a client. MaxHoldingSeconds of
+// returned messages is set to the remaining holding time.
 func (messageBuffer *MessageBuffer) PopMessages(clientID []byte) []*pb.Relay {
-	clientIDStr := hex.EncodeToString(clientID)
 	messageBuffer.Lock()
 	defer messageBuffer.Unlock()
-	messages := messageBuffer.buffer[clientIDStr]
-	messageBuffer.buffer[clientIDStr] = nil
+
+	cb, ok := messageBuffer.buffer[hex.EncodeToString(clientID)]
+	if !ok {
+		return nil
+	}
+
+	now := time.Now()
+	messages := make([]*pb.Relay, 0, cb.messages.Len())
+	for cb.messages.Len() > 0 {
+		m := cb.messages.Front().Value.(*bufferedMessage)
+		messageBuffer.remove(m)
+		if !now.Before(m.expiresAt) {
+			messageBuffer.numExpired++
+			continue
+		}
+		m.msg.MaxHoldingSeconds = uint32((m.expiresAt.Sub(now) + time.Second - 1) / time.Second)
+		messages = append(messages, m.msg)
+		messageBuffer.numPopped++
+	}
+
 	return messages
 }
+
+// GetStats returns the statistics of message buffer
+func (messageBuffer *MessageBuffer) GetStats() *Stats {
+	messageBuffer.Lock()
+	defer messageBuffer.Unlock()
+	return &Stats{
+		NumClients:   len(messageBuffer.buffer),
+		NumSources:   len(messageBuffer.sources),
+		NumMessages:  messageBuffer.messages.Len(),
+		TotalSize:    messageBuffer.totalSize,
+		NumAdded:     messageBuffer.numAdded,
+		NumPopped:    messageBuffer.numPopped,
+		NumExpired:   messageBuffer.numExpired,
+		NumEvicted:   messageBuffer.numEvicted,
+		NumRejected:  messageBuffer.numRejected,
+		IsPersistent: messageBuffer.store != nil,
+	}
+}
+
+// canHold returns if message can be added after evicting older messages.
+func (messageBuffer *MessageBuffer) canHold(m *bufferedMessage) bool {
+	if messageBuffer.maxMsgsPerClient <= 0 {
+		return false
+	}
+	if messageBuffer.maxSizePerClient > 0 && m.size > messageBuffer.maxSizePerClient {
+		return false
+	}
+	if messageBuffer.maxSizePerSource > 0 && m.size > messageBuffer.maxSizePerSource {
+		return false
+	}
+	if messageBuffer.maxTotalSize > 0 && m.size > messageBuffer.maxTotalSize {
+		return false
+	}
+	return true
+}
+
+// add adds a message and evicts oldest messages if limit is exceeded. Caller
+// should hold the lock.
+func (messageBuffer *MessageBuffer) add(m *bufferedMessage) {
+	clientIDStr := hex.EncodeToString(m.clientID)
+	cb, ok := messageBuffer.buffer[clientIDStr]
+	if !ok {
+		cb = &clientBuffer{messages: list.New()}
+		messageBuffer.buffer[clientIDStr] = cb
+	}
+
+	for cb.messages.Len() >= messageBuffer.maxMsgsPerClient || (messageBuffer.maxSizePerClient > 0 && cb.size+m.size > messageBuffer.maxSizePerClient) {
+		messageBuffer.evict(cb.messages.Front().Value.(*bufferedMessage))
+	}
+
+	sb, ok := messageBuffer.sources[m.source]
+	if !ok {
+		sb = &clientBuffer{messages: list.New()}
+		messageBuffer.sources[m.source] = sb
+	}
+
+	for messageBuffer.maxSizePerSource > 0 && sb.size+m.size > messageBuffer.maxSizePerSource {
+		messageBuffer.evict(sb.messages.Front().Value.(*bufferedMessage))
+	}
+
+	for messageBuffer.maxTotalSize > 0 && messageBuffer.totalSize+m.size > messageBuffer.maxTotalSize {
+		messageBuffer.evict(messageBuffer.messages.Front().Value.(*bufferedMessage))
+	}
+
+	// client or source buffer might be deleted when evicting its last message
+	if _, ok := messageBuffer.buffer[clientIDStr]; !ok {
+		messageBuffer.buffer[clientIDStr] = cb
+	}
+	if _, ok := messageBuffer.sources[m.source]; !ok {
+		messageBuffer.sources[m.source] = sb
+	}
+
+	m.clientElement = cb.messages.PushBack(m)
+	m.sourceElement = sb.messages.PushBack(m)
+	m.globalElement = messageBuffer.messages.PushBack(m)
+	cb.size += m.size
+	sb.size += m.size
+	messageBuffer.totalSize += m.size
+}
+
+// remove removes a message from buffer and db. Caller should hold the lock.
+func (messageBuffer *MessageBuffer) remove(m *bufferedMessage) {
+	clientIDStr := hex.EncodeToString(m.clientID)
+	cb := messageBuffer.buffer[clientIDStr]
+	cb.messages.Remove(m.clientElement)
+	cb.size -= m.size
+	if cb.messages.Len() == 0 {
+		delete(messageBuffer.buffer, clientIDStr)
+	}
+
+	sb := messageBuffer.sources[m.source]
+	sb.messages.Remove(m.sourceElement)
+	sb.size -= m.size
+	if sb.messages.Len() == 0 {
+		delete(messageBuffer.sources, m.source)
+	}
+
+	messageBuffer.messages.Remove(m.globalElement)
+	messageBuffer.totalSize -= m.size
+
+	if messageBuffer.store != nil {
+		err := messageBuffer.store.Delete(db.MessageBufferKey(m.clientID, m.seq))
+		if err != nil {
+			log.Errorf("Delete buffered message error: %v", err)
+		}
+	}
+}
+
+func (messageBuffer *MessageBuffer) evict(m *bufferedMessage) {
+	messageBuffer.remove(m)
+	messageBuffer.numEvicted++
+}
+
+// cleanup removes expired messages periodically.
+func (messageBuffer *MessageBuffer) cleanup() {
+	for {
+		time.Sleep(cleanupInterval)
+		messageBuffer.Lock()
+		now := time.Now()
+		var next *list.Element
+		for e := messageBuffer.messages.Front(); e != nil; e = next {
+			next = e.Next()
+			m := e.Value.(*bufferedMessage)
+			if !now.Before(m.expiresAt) {
+				messageBuffer.remove(m)
+				messageBuffer.numExpired++
+			}
+		}
+		messageBuffer.Unlock()
+	}
+}
+
+// load loads buffered messages from db in the order of being added.
+func (messageBuffer *MessageBuffer) load() error {
+	prefix := db.MessageBufferPrefix()
+	iter := messageBuffer.store.NewIterator(prefix)
+	defer iter.Release()
+
+	var messages []*bufferedMessage
+	for iter.Next() {
+		key := iter.Key()
+		if len(key) < len(prefix)+8 {
+			return errors.New("invalid buffered message key")
+		}
+		m, err := decodeMessage(iter.Value())
+		if err != nil {
+			return err
+		}
+		m.clientID = append([]byte(nil), key[len(prefix):len(key)-8]...)
+		m.source = hex.EncodeToString(m.msg.SrcPubkey)
+		m.seq = binary.BigEndian.Uint64(key[len(key)-8:])
+		messages = append(messages, m)
+	}
+
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].seq < messages[j].seq
+	})
+
+	now := time.Now()
+	for _, m := range messages {
+		if m.seq >= messageBuffer.nextSeq {
+			messageBuffer.nextSeq = m.seq + 1
+		}
+		if !now.Before(m.expiresAt) || !messageBuffer.canHold(m) {
+			err := messageBuffer.store.Delete(db.MessageBufferKey(m.clientID, m.seq))
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		messageBuffer.add(m)
+	}
+
+	log.Infof("Loaded %d buffered messages of %d clients", messageBuffer.messages.Len(), len(messageBuffer.buffer))
+
+	return nil
+}
+
+func encodeMessage(m *bufferedMessage) ([]byte, error) {
+	buf, err := proto.Marshal(m.msg)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, 8+len(buf))
+	binary.BigEndian.PutUint64(b, uint64(m.expiresAt.UnixNano()))
+	copy(b[8:], buf)
+	return b, nil
+}
+
+func decodeMessage(b []byte) (*bufferedMessage, error) {
+	if len(b) < 8 {
+		return nil, errors.New("invalid buffered message length")
+	}
+	msg := &pb.Relay{}
+	err := proto.Unmarshal(b[8:], msg)
+	if err != nil {
+		return nil, err
+	}
+	return &bufferedMessage{
+		msg:       msg,
+		size:      msg.Size(),
+		expiresAt: time.Unix(0, int64(binary.BigEndian.Uint64(b[:8]))),
+	}, nil
+}
@@ -0,0 +1,95 @@
+package messagebuffer
+
+import (
+	"container/list"
+	"encoding/hex"
+	"testing"
+
+	"github.com/nknorg/nkn/pb"
+)
+
+func newTestMessageBuffer(maxMsgsPerClient, maxSizePerClient, maxSizePerSource, maxTotalSize int) *MessageBuffer {
+	return &MessageBuffer{
+		buffer:           make(map[string]*clientBuffer),
+		sources:          make(map[string]*clientBuffer),
+		messages:         list.New(),
+		maxMsgsPerClient: maxMsgsPerClient,
+		maxSizePerClient: maxSizePerClient,
+		maxSizePerSource: maxSizePerSource,
+		maxTotalSize:     maxTotalSize,
+	}
+}
+
+func newTestRelay(src byte, payloadSize int) *pb.Relay {
+	return &pb.Relay{
+		SrcPubkey:         []byte{src},
+		Payload:           make([]byte, payloadSize),
+		MaxHoldingSeconds: 3600,
+	}
+}
+
+func TestAddMessage(t *testing.T) {
+	size := newTestRelay(0, 10).Size()
+	mb := newTestMessageBuffer(2, 10*size, 3*size, 5*size)
+
+	tests := []struct {
+		name        string
+		clientID    byte
+		src         byte
+		payloadSize int
+		buffered    bool
+		numMessages map[byte]int
+	}{
+		{"first message", 1, 1, 10, true, map[byte]int{1: 1}},
+		{"second message of client", 1, 1, 10, true, map[byte]int{1: 2}},
+		{"per client count limit", 1, 1, 10, true, map[byte]int{1: 2}},
+		{"other client same source", 2, 1, 10, true, map[byte]int{1: 2, 2: 1}},
+		{"per source size limit", 3, 1, 10, true, map[byte]int{1: 1, 2: 1, 3: 1}},
+		{"source spread over clients", 4, 1, 10, true, map[byte]int{1: 0, 2: 1, 3: 1, 4: 1}},
+		{"other source not evicted", 5, 2, 10, true, map[byte]int{2: 1, 3: 1, 4: 1, 5: 1}},
+		{"other source", 5, 2, 10, true, map[byte]int{2: 1, 3: 1, 4: 1, 5: 2}},
+		{"total size limit", 6, 2, 10, true, map[byte]int{2: 0, 3: 1, 4: 1, 5: 2, 6: 1}},
+		{"larger than source limit", 7, 3, 4 * size, false, map[byte]int{3: 1, 4: 1, 5: 2, 6: 1, 7: 0}},
+	}
+
+	for _, test := range tests {
+		if buffered := mb.AddMessage([]byte{test.clientID}, newTestRelay(test.src, test.payloadSize)); buffered != test.buffered {
+			t.Fatalf("%s: expect buffered %v, got %v", test.name, test.buffered, buffered)
+		}
+		for clientID, numMessages := range test.numMessages {
+			n := 0
+			if cb, ok := mb.buffer[hex.EncodeToString([]byte{clientID})]; ok {
+				n = cb.messages.Len()
+			}
+			if n != numMessages {
+				t.Fatalf("%s: expect %d messages for client %d, got %d", test.name, numMessages, clientID, n)
+			}
+		}
+		for source, sb := range mb.sources {
+			if sb.size > mb.maxSizePerSource {
+				t.Fatalf("%s: source %s size %d exceeds limit", test.name, source, sb.size)
+			}
+		}
+		if mb.totalSize > mb.maxTotalSize {
+			t.Fatalf("%s: total size %d exceeds limit", test.name, mb.totalSize)
+		}
+	}
+}
+
+func TestPopMessages(t *testing.T) {
+	mb := newTestMessageBuffer(10, 0, 0, 0)
+	mb.AddMessage([]byte{1}, newTestRelay(1, 1))
+	mb.AddMessage([]byte{1}, newTestRelay(2, 2))
+	mb.AddMessage([]byte{2}, newTestRelay(1, 3))
+
+	messages := mb.PopMessages([]byte{1})
+	if len(messages) != 2 || len(messages[0].Payload) != 1 || len(messages[1].Payload) != 2 {
+		t.Fatalf("expect 2 messages in order, got %v", messages)
+	}
+	if len(mb.buffer) != 1 || len(mb.sources) != 1 || mb.sources["01"].messages.Len() != 1 {
+		t.Fatalf("expect 1 client and 1 source left, got %d clients and %d sources", len(mb.buffer), len(mb.sources))
+	}
+	if mb.totalSize != newTestRelay(1, 3).Size() {
+		t.Fatalf("expect total size %d, got %d", newTestRelay(1, 3).Size(), mb.totalSize)
+	}
+}
@@ -0,0 +1,194 @@
+package server
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/util/log"
+)
+
+const (
+	maxNumFragments         = 4096
+	fragmentCleanupInterval = time.Second
+	// maxPartialMessages is the max number of incomplete messages buffered
+	maxPartialMessages = 1024
+	// maxPartialMessagesPerSource is the max number of incomplete messages
+	// buffered from the same source client
+	maxPartialMessagesPerSource = 16
+	// maxBufferedFragmentSize is the max total size of buffered fragments
+	maxBufferedFragmentSize = 256 << 20
+)
+
+var (
+	errTooManyPartialMessages = errors.New("too many incomplete fragmented messages")
+	errFragmentBufferFull     = errors.New("fragment buffer is full")
+	errInconsistentFragment   = errors.New("fragment header is inconsistent with other fragments")
+)
+
+// partialMessage holds received fragments of a relay message
+type partialMessage struct {
+	relay     *pb.Relay
+	source    string
+	fragments map[uint32][]byte
+	size      int
+	expiresAt time.Time
+}
+
+// fragmentReassembler buffers fragments of relay messages until all fragments
+// of a message are received or the message expires. Number of buffered
+// messages in total and per source, and total size of buffered fragments are
+// limited.
+type fragmentReassembler struct {
+	sync.Mutex
+	messages     map[string]*partialMessage
+	sourceCount  map[string]int
+	totalSize    int
+	maxMessages  int
+	maxPerSource int
+	maxTotalSize int
+	onExpired    func(relay *pb.Relay, missingFragments []uint32)
+}
+
+func newFragmentReassembler(onExpired func(relay *pb.Relay, missingFragments []uint32)) *fragmentReassembler {
+	fr := &fragmentReassembler{
+		messages:     make(map[string]*partialMessage),
+		sourceCount:  make(map[string]int),
+		maxMessages:  maxPartialMessages,
+		maxPerSource: maxPartialMessagesPerSource,
+		maxTotalSize: maxBufferedFragmentSize,
+		onExpired:    onExpired,
+	}
+	go fr.cleanup()
+	return fr
+}
+
+func fragmentKey(relay *pb.Relay) string {
+	return hex.EncodeToString(relay.DestId) + hex.EncodeToString(relay.FragmentId)
+}
+
+// isSameMessage returns whether fragment has the same header as the first
+// received fragment, since header of reassembled message is taken from it.
+func isSameMessage(first, relay *pb.Relay) bool {
+	return relay.NumFragments == first.NumFragments &&
+		relay.SrcIdentifier == first.SrcIdentifier &&
+		bytes.Equal(relay.SrcPubkey, first.SrcPubkey) &&
+		bytes.Equal(relay.LastSignature, first.LastSignature) &&
+		bytes.Equal(relay.BlockHash, first.BlockHash)
+}
+
+// removeMessage removes a partial message. Caller should hold the lock.
+func (fr *fragmentReassembler) removeMessage(key string, pm *partialMessage) {
+	delete(fr.messages, key)
+	fr.totalSize -= pm.size
+	fr.sourceCount[pm.source]--
+	if fr.sourceCount[pm.source] <= 0 {
+		delete(fr.sourceCount, pm.source)
+	}
+}
+
+// addFragment adds a fragment and returns the reassembled relay message if all
+// fragments are received, or nil otherwise.
+func (fr *fragmentReassembler) addFragment(relay *pb.Relay) (*pb.Relay, error) {
+	if relay.NumFragments > maxNumFragments {
+		return nil, errors.New("too many fragments")
+	}
+	if relay.FragmentIndex >= relay.NumFragments {
+		return nil, errors.New("fragment index out of range")
+	}
+
+	fr.Lock()
+	defer fr.Unlock()
+
+	if fr.totalSize+len(relay.Payload) > fr.maxTotalSize {
+		return nil, errFragmentBufferFull
+	}
+
+	key := fragmentKey(relay)
+	pm, ok := fr.messages[key]
+	if !ok {
+		source := hex.EncodeToString(relay.SrcPubkey)
+		if len(fr.messages) >= fr.maxMessages {
+			return nil, errTooManyPartialMessages
+		}
+		if fr.sourceCount[source] >= fr.maxPerSource {
+			return nil, errTooManyPartialMessages
+		}
+		pm = &partialMessage{
+			relay:     relay,
+			source:    source,
+			fragments: make(map[uint32][]byte),
+			expiresAt: time.Now().Add(config.Parameters.RelayFragmentTimeout * time.Second),
+		}
+		fr.messages[key] = pm
+		fr.sourceCount[source]++
+	} else if !isSameMessage(pm.relay, relay) {
+		return nil, errInconsistentFragment
+	}
+
+	if _, ok := pm.fragments[relay.FragmentIndex]; ok {
+		return nil, nil
+	}
+
+	if config.Parameters.RelayMaxPayloadSize > 0 && pm.size+len(relay.Payload) > int(config.Parameters.RelayMaxPayloadSize) {
+		fr.removeMessage(key, pm)
+		return nil, errors.New("reassembled payload size exceeds limit")
+	}
+
+	pm.fragments[relay.FragmentIndex] = relay.Payload
+	pm.size += len(relay.Payload)
+	fr.totalSize += len(relay.Payload)
+
+	if len(pm.fragments) < int(pm.relay.NumFragments) {
+		return nil, nil
+	}
+
+	fr.removeMessage(key, pm)
+
+	payload := make([]byte, 0, pm.size)
+	for i := uint32(0); i < pm.relay.NumFragments; i++ {
+		payload = append(payload, pm.fragments[i]...)
+	}
+
+	msg := *pm.relay
+	msg.Payload = payload
+	msg.FragmentId = nil
+	msg.FragmentIndex = 0
+	msg.NumFragments = 0
+
+	return &msg, nil
+}
+
+func (fr *fragmentReassembler) cleanup() {
+	for {
+		time.Sleep(fragmentCleanupInterval)
+
+		now := time.Now()
+		expired := make([]*partialMessage, 0)
+		fr.Lock()
+		for key, pm := range fr.messages {
+			if now.After(pm.expiresAt) {
+				expired = append(expired, pm)
+				fr.removeMessage(key, pm)
+			}
+		}
+		fr.Unlock()
+
+		for _, pm := range expired {
+			missingFragments := make([]uint32, 0, int(pm.relay.NumFragments)-len(pm.fragments))
+			for i := uint32(0); i < pm.relay.NumFragments; i++ {
+				if _, ok := pm.fragments[i]; !ok {
+					missingFragments = append(missingFragments, i)
+				}
+			}
+			log.Infof("Drop incomplete relay message to %x with %d missing fragments", pm.relay.DestId, len(missingFragments))
+			if fr.onExpired != nil {
+				fr.onExpired(pm.relay, missingFragments)
+			}
+		}
+	}
+}
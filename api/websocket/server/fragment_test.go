@@ -0,0 +1,137 @@
+package server
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/util/config"
+)
+
+func newTestFragment(src, fragmentID byte, index, numFragments uint32, payload []byte) *pb.Relay {
+	return &pb.Relay{
+		SrcPubkey:     []byte{src},
+		DestId:        []byte{0},
+		LastSignature: []byte{src},
+		Payload:       payload,
+		FragmentId:    []byte{fragmentID},
+		FragmentIndex: index,
+		NumFragments:  numFragments,
+	}
+}
+
+func newTestReassembler(maxMessages, maxPerSource, maxTotalSize int) *fragmentReassembler {
+	return &fragmentReassembler{
+		messages:     make(map[string]*partialMessage),
+		sourceCount:  make(map[string]int),
+		maxMessages:  maxMessages,
+		maxPerSource: maxPerSource,
+		maxTotalSize: maxTotalSize,
+	}
+}
+
+func TestAddFragment(t *testing.T) {
+	maxPayloadSize, timeout := config.Parameters.RelayMaxPayloadSize, config.Parameters.RelayFragmentTimeout
+	defer func() {
+		config.Parameters.RelayMaxPayloadSize = maxPayloadSize
+		config.Parameters.RelayFragmentTimeout = timeout
+	}()
+	config.Parameters.RelayMaxPayloadSize = 10
+	config.Parameters.RelayFragmentTimeout = 60
+
+	inconsistent := newTestFragment(1, 1, 1, 3, []byte("b"))
+	inconsistent.LastSignature = []byte{2}
+
+	fr := newTestReassembler(3, 2, 8)
+	tests := []struct {
+		name     string
+		relay    *pb.Relay
+		payload  []byte
+		hasError bool
+	}{
+		{"too many fragments", newTestFragment(1, 0, 0, maxNumFragments+1, []byte("a")), nil, true},
+		{"index out of range", newTestFragment(1, 0, 2, 2, []byte("a")), nil, true},
+		{"last fragment first", newTestFragment(1, 1, 2, 3, []byte("c")), nil, false},
+		{"duplicate fragment", newTestFragment(1, 1, 2, 3, []byte("c")), nil, false},
+		{"different signature", inconsistent, nil, true},
+		{"different number of fragments", newTestFragment(1, 1, 1, 4, []byte("b")), nil, true},
+		{"first fragment", newTestFragment(1, 1, 0, 3, []byte("a")), nil, false},
+		{"complete", newTestFragment(1, 1, 1, 3, []byte("b")), []byte("abc"), false},
+		{"second message of source", newTestFragment(1, 2, 0, 2, []byte("a")), nil, false},
+		{"third message of source", newTestFragment(1, 3, 0, 2, []byte("a")), nil, false},
+		{"per source limit", newTestFragment(1, 4, 0, 2, []byte("a")), nil, true},
+		{"other source", newTestFragment(2, 5, 0, 2, []byte("a")), nil, false},
+		{"total message limit", newTestFragment(3, 6, 0, 2, []byte("a")), nil, true},
+		{"total size limit", newTestFragment(2, 5, 1, 2, []byte("123456")), nil, true},
+		{"within total size limit", newTestFragment(2, 5, 1, 2, []byte("12345")), []byte("a12345"), false},
+	}
+
+	for _, test := range tests {
+		msg, err := fr.addFragment(test.relay)
+		if (err != nil) != test.hasError {
+			t.Fatalf("%s: expect error %v, got %v", test.name, test.hasError, err)
+		}
+		if test.payload == nil && msg != nil {
+			t.Fatalf("%s: expect no message, got payload %q", test.name, msg.Payload)
+		}
+		if test.payload != nil {
+			if msg == nil || !bytes.Equal(msg.Payload, test.payload) {
+				t.Fatalf("%s: expect payload %q, got %v", test.name, test.payload, msg)
+			}
+			if msg.NumFragments != 0 || msg.FragmentId != nil || !bytes.Equal(msg.SrcPubkey, test.relay.SrcPubkey) {
+				t.Fatalf("%s: reassembled message has wrong header", test.name)
+			}
+		}
+	}
+}
+
+func TestAddFragmentPayloadLimit(t *testing.T) {
+	maxPayloadSize := config.Parameters.RelayMaxPayloadSize
+	defer func() { config.Parameters.RelayMaxPayloadSize = maxPayloadSize }()
+	config.Parameters.RelayMaxPayloadSize = 4
+
+	fr := newTestReassembler(10, 10, 100)
+	if _, err := fr.addFragment(newTestFragment(1, 1, 0, 2, []byte("abc"))); err != nil {
+		t.Fatalf("add first fragment error: %v", err)
+	}
+	if _, err := fr.addFragment(newTestFragment(1, 1, 1, 2, []byte("de"))); err == nil {
+		t.Fatal("expect error when reassembled payload exceeds limit")
+	}
+	if len(fr.messages) != 0 || fr.totalSize != 0 || len(fr.sourceCount) != 0 {
+		t.Fatalf("expect message to be removed, got %d messages, %d bytes", len(fr.messages), fr.totalSize)
+	}
+}
+
+func TestFragmentExpire(t *testing.T) {
+	timeout := config.Parameters.RelayFragmentTimeout
+	defer func() { config.Parameters.RelayFragmentTimeout = timeout }()
+	config.Parameters.RelayFragmentTimeout = 0
+
+	type expiredMessage struct {
+		relay            *pb.Relay
+		missingFragments []uint32
+	}
+	expired := make(chan expiredMessage, 1)
+	fr := newFragmentReassembler(func(relay *pb.Relay, missingFragments []uint32) {
+		expired <- expiredMessage{relay, missingFragments}
+	})
+
+	fr.addFragment(newTestFragment(1, 1, 1, 4, []byte("b")))
+	fr.addFragment(newTestFragment(1, 1, 3, 4, []byte("d")))
+
+	select {
+	case m := <-expired:
+		if len(m.missingFragments) != 2 || m.missingFragments[0] != 0 || m.missingFragments[1] != 2 {
+			t.Fatalf("expect missing fragments [0 2], got %v", m.missingFragments)
+		}
+	case <-time.After(3 * fragmentCleanupInterval):
+		t.Fatal("expect incomplete message to expire")
+	}
+
+	fr.Lock()
+	defer fr.Unlock()
+	if len(fr.messages) != 0 || fr.totalSize != 0 || len(fr.sourceCount) != 0 {
+		t.Fatalf("expect expired message to be removed, got %d messages, %d bytes", len(fr.messages), fr.totalSize)
+	}
+}
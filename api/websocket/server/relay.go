@@ -7,10 +7,14 @@ import (
 	"strings"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/nknorg/nkn/api/common"
+	"github.com/nknorg/nkn/api/websocket/session"
 	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/node"
 	"github.com/nknorg/nkn/pb"
 	"github.com/nknorg/nkn/por"
 	"github.com/nknorg/nkn/util/address"
+	"github.com/nknorg/nkn/util/config"
 	"github.com/nknorg/nkn/util/log"
 )
 
@@ -33,29 +37,242 @@ func ResolveDest(Dest string) string {
 	return strings.Join(substrings, ".")
 }
 
-func (ws *WsServer) sendOutboundRelayMessage(srcAddrStrPtr *string, msg *pb.OutboundMessage) {
+// publishResult is the result of publishing a message to topic subscribers
+type publishResult struct {
+	Topic    string `json:"topic"`
+	NumDests int    `json:"numDests"`
+	NumSent  int    `json:"numSent"`
+}
+
+// getTopicSubscribers returns at most limit subscribers of a topic in ledger
+// and txpool starting from offset, and whether there are more subscribers.
+func (ws *WsServer) getTopicSubscribers(topic string, offset, limit int) ([]string, bool, error) {
+	subscribers := make([]string, 0)
+	subscriberSet := make(map[string]struct{})
+	addSubscribers := func(addrs []string) {
+		for _, addr := range addrs {
+			if _, ok := subscriberSet[addr]; ok {
+				continue
+			}
+			subscriberSet[addr] = struct{}{}
+			subscribers = append(subscribers, addr)
+		}
+	}
+
+	// one more than needed to know if there are more subscribers
+	queryLimit := offset + limit + 1
+
+	height := chain.DefaultLedger.Store.GetHeight()
+	maxBucket := uint32(config.MaxSubscribeBucket.GetValueAtHeight(height))
+	for bucket := uint32(0); bucket <= maxBucket && len(subscribers) < queryLimit; bucket++ {
+		addrs, err := chain.DefaultLedger.Store.GetSubscribers(topic, bucket, 0, uint32(queryLimit))
+		if err != nil {
+			return nil, false, err
+		}
+		addSubscribers(addrs)
+	}
+
+	if len(subscribers) < queryLimit {
+		addSubscribers(ws.localNode.GetTxnPool().GetSubscribers(topic))
+	}
+
+	if offset >= len(subscribers) {
+		return []string{}, false, nil
+	}
+	subscribers = subscribers[offset:]
+
+	if len(subscribers) > limit {
+		return subscribers[:limit], true, nil
+	}
+
+	return subscribers, false, nil
+}
+
+// getTopicDests gets one page of subscribers of a topic in ledger and txpool,
+// which is the first step of publishing to a topic. Relays can not be fanned
+// out by node alone, because the sigchain of each relay starts with a client
+// signature that covers its destination. Client signs for each destination
+// and then sends an outbound message with topic, dests and signatures.
+// params: {"Topic":<topic>, "Offset":<offset>, "Limit":<limit>}
+// return: {"resultOrData":<result>|<error data>, "error":<errcode>}
+func (ws *WsServer) getTopicDests(s common.Serverer, cmd map[string]interface{}) map[string]interface{} {
+	topic, ok := cmd["Topic"].(string)
+	if !ok || len(topic) == 0 {
+		return common.RespPacking(nil, common.INVALID_PARAMS)
+	}
+
+	maxDests := int(config.Parameters.MaxPublishDests)
+	limit := maxDests
+	if v, ok := cmd["Limit"].(float64); ok {
+		if v < 1 || int(v) > maxDests {
+			return common.RespPacking(nil, common.INVALID_PARAMS)
+		}
+		limit = int(v)
+	}
+
+	offset := 0
+	if v, ok := cmd["Offset"].(float64); ok {
+		if v < 0 {
+			return common.RespPacking(nil, common.INVALID_PARAMS)
+		}
+		offset = int(v)
+	}
+
+	dests, hasMore, err := ws.getTopicSubscribers(topic, offset, limit)
+	if err != nil {
+		log.Warningf("Get subscribers of topic %s error: %v", topic, err)
+		return common.RespPacking(nil, common.INTERNAL_ERROR)
+	}
+
+	return common.RespPacking(map[string]interface{}{
+		"topic":   topic,
+		"dests":   dests,
+		"offset":  offset,
+		"hasMore": hasMore,
+	}, common.SUCCESS)
+}
+
+// isTopicSubscriber returns whether dest is a subscriber of topic in ledger
+// or txpool.
+func (ws *WsServer) isTopicSubscriber(topic, dest string) (bool, error) {
+	_, pubKey, identifier, err := address.ParseClientAddress(dest)
+	if err != nil {
+		return false, err
+	}
+
+	height := chain.DefaultLedger.Store.GetHeight()
+	maxBucket := uint32(config.MaxSubscribeBucket.GetValueAtHeight(height))
+	for bucket := uint32(0); bucket <= maxBucket; bucket++ {
+		subscribed, err := chain.DefaultLedger.Store.IsSubscribed(topic, bucket, pubKey, identifier)
+		if err != nil {
+			return false, err
+		}
+		if subscribed {
+			return true, nil
+		}
+	}
+
+	subscriber := address.MakeAddressString(pubKey, identifier)
+	for _, addr := range ws.localNode.GetTxnPool().GetSubscribers(topic) {
+		if addr == subscriber {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// checkRelayLimit checks and consumes relay quota of client session for
+// sending payload to numDests destinations.
+func (ws *WsServer) checkRelayLimit(srcSession *session.Session, payloadSize, numDests int) (common.ErrCode, error) {
+	err := ws.localNode.CheckOutboundRelayLimit(srcSession.GetPubKey(), srcSession.GetRemoteIP(), payloadSize, numDests)
+	switch err {
+	case nil:
+		return common.SUCCESS, nil
+	case node.ErrPayloadTooLarge:
+		return common.PAYLOAD_TOO_LARGE, err
+	default:
+		return common.RATE_LIMITED, err
+	}
+}
+
+// sendOutboundRelayMessage sends message to each destination with the
+// signature of client for it. At most MaxPublishDests destinations are allowed
+// in one message. If topic is set, each destination should be a subscriber of
+// the topic, e.g. returned by getTopicDests, and the number of destinations
+// reached is reported back to client.
+func (ws *WsServer) sendOutboundRelayMessage(srcSession *session.Session, msg *pb.OutboundMessage) {
+	srcAddrStrPtr := srcSession.GetAddrStr()
 	if srcAddrStrPtr == nil {
 		log.Warningf("src addr is nil")
 		return
 	}
 
+	action := "outboundMessage"
+	if len(msg.Topic) > 0 {
+		action = "publish"
+	}
+
+	respondError := func(errCode common.ErrCode, err error) {
+		log.Warningf("Reject outbound message from %s: %v", *srcAddrStrPtr, err)
+		resp := common.ResponsePack(errCode)
+		resp["Action"] = action
+		resp["Result"] = err.Error()
+		ws.respondToSession(srcSession, resp)
+	}
+
+	// access list might be reloaded after client session is set up
+	if err := ws.localNode.CheckClientAccess(*srcAddrStrPtr); err != nil {
+		respondError(common.ACCESS_DENIED, err)
+		return
+	}
+
 	dests := msg.Dests
 	if len(dests) == 0 && len(msg.Dest) > 0 {
 		dests = append(dests, msg.Dest)
 	}
 
 	if len(dests) == 0 {
+		if len(msg.Topic) > 0 {
+			respondError(common.INVALID_PARAMS, errors.New("no destination, get topic dests and sign for each of them first"))
+			return
+		}
 		log.Warningf("no destination")
 		return
 	}
 
+	if len(msg.Signatures) < len(dests) {
+		respondError(common.INVALID_PARAMS, fmt.Errorf("%d signatures for %d destinations", len(msg.Signatures), len(dests)))
+		return
+	}
+
+	if len(dests) > int(config.Parameters.MaxPublishDests) {
+		respondError(common.INVALID_PARAMS, fmt.Errorf("number of destinations exceeds limit %d", config.Parameters.MaxPublishDests))
+		return
+	}
+
+	resolvedDests := make([]string, len(dests))
 	for i, dest := range dests {
-		dest = ResolveDest(dest)
+		resolvedDests[i] = ResolveDest(dest)
+		if len(msg.Topic) == 0 {
+			continue
+		}
+		subscribed, err := ws.isTopicSubscriber(msg.Topic, resolvedDests[i])
+		if err != nil {
+			respondError(common.INVALID_PARAMS, fmt.Errorf("check subscriber %s error: %v", dest, err))
+			return
+		}
+		if !subscribed {
+			respondError(common.INVALID_PARAMS, fmt.Errorf("%s is not a subscriber of topic %s", dest, msg.Topic))
+			return
+		}
+	}
 
-		err := ws.localNode.SendRelayMessage(*srcAddrStrPtr, dest, msg.Payload, msg.Signatures[i], msg.BlockHash, msg.Nonce, msg.MaxHoldingSeconds)
+	errCode, err := ws.checkRelayLimit(srcSession, len(msg.Payload), len(dests))
+	if err != nil {
+		respondError(errCode, err)
+		return
+	}
+
+	numSent := 0
+	for i, dest := range resolvedDests {
+		err := ws.localNode.SendRelayMessage(*srcAddrStrPtr, dest, msg.Payload, msg.Signatures[i], msg.BlockHash, msg.Nonce, msg.MaxHoldingSeconds, msg.MessageId, msg.RequestAck, msg.Trace)
 		if err != nil {
 			log.Error("Send relay message error:", err)
+			continue
 		}
+		numSent++
+	}
+
+	if len(msg.Topic) > 0 {
+		resp := common.ResponsePack(common.SUCCESS)
+		resp["Action"] = action
+		resp["Result"] = &publishResult{
+			Topic:    msg.Topic,
+			NumDests: len(dests),
+			NumSent:  numSent,
+		}
+		ws.respondToSession(srcSession, resp)
 	}
 }
 
@@ -102,6 +319,33 @@ func (ws *WsServer) sendInboundMessage(clientID string, inboundMsg *pb.InboundMe
 }
 
 func (ws *WsServer) sendInboundRelayMessage(relayMessage *pb.Relay) {
+	if relayMessage.NumFragments > 1 {
+		msg, err := ws.reassembler.addFragment(relayMessage)
+		if err != nil {
+			log.Warningf("Add relay message fragment error: %v", err)
+			return
+		}
+		if msg == nil {
+			return
+		}
+		relayMessage = msg
+	}
+
+	if detached, buffered := ws.queueDetachedMessage(relayMessage); detached {
+		if buffered {
+			ws.sendDeliveryAck(relayMessage, pb.BUFFERED)
+		} else {
+			ws.sendDeliveryAck(relayMessage, pb.DROPPED)
+		}
+		return
+	}
+
+	ws.deliverInboundRelayMessage(relayMessage)
+}
+
+// deliverInboundRelayMessage sends relay message to client, or buffers it if
+// client is not online.
+func (ws *WsServer) deliverInboundRelayMessage(relayMessage *pb.Relay) {
 	clientID := relayMessage.DestId
 	msg := &pb.InboundMessage{
 		Src:     address.AssembleClientAddress(relayMessage.SrcIdentifier, relayMessage.SrcPubkey),
@@ -113,6 +357,7 @@ func (ws *WsServer) sendInboundRelayMessage(relayMessage *pb.Relay) {
 		msg.PrevSignature = relayMessage.LastSignature
 	}
 
+	status := pb.DELIVERED
 	success := ws.sendInboundMessage(hex.EncodeToString(clientID), msg)
 	if success {
 		if shouldSign {
@@ -121,8 +366,84 @@ func (ws *WsServer) sendInboundRelayMessage(relayMessage *pb.Relay) {
 				sigChainLen: int(relayMessage.SigChainLen),
 			})
 		}
+	} else if ws.messageBuffer.AddMessage(clientID, relayMessage) {
+		status = pb.BUFFERED
 	} else {
-		ws.messageBuffer.AddMessage(clientID, relayMessage)
+		status = pb.DROPPED
+	}
+
+	ws.sendDeliveryAck(relayMessage, status)
+}
+
+func (ws *WsServer) sendDeliveryAck(relayMessage *pb.Relay, status pb.DeliveryStatus) {
+	switch status {
+	case pb.DELIVERED:
+		ws.localNode.TraceRelayMessage(relayMessage, pb.TRACE_DELIVERED, "")
+	case pb.BUFFERED:
+		ws.localNode.TraceRelayMessage(relayMessage, pb.TRACE_BUFFERED, "")
+	case pb.DROPPED:
+		ws.localNode.TraceRelayMessage(relayMessage, pb.TRACE_DROPPED, "client offline and message buffer full")
+	}
+
+	if !relayMessage.RequestAck && !relayMessage.Trace {
+		return
+	}
+
+	err := ws.localNode.SendDeliveryAck(relayMessage, status, nil)
+	if err != nil {
+		log.Warningf("Send delivery ack error: %v", err)
+	}
+}
+
+func (ws *WsServer) sendIncompleteAck(relayMessage *pb.Relay, missingFragments []uint32) {
+	ws.localNode.TraceRelayMessage(relayMessage, pb.TRACE_DROPPED, "incomplete fragments")
+
+	if !relayMessage.RequestAck && !relayMessage.Trace {
+		return
+	}
+
+	err := ws.localNode.SendDeliveryAck(relayMessage, pb.INCOMPLETE, missingFragments)
+	if err != nil {
+		log.Warningf("Send delivery ack error: %v", err)
+	}
+}
+
+func (ws *WsServer) sendDeliveryAckToClient(v interface{}) {
+	msg, ok := v.(*pb.RelayDeliveryAck)
+	if !ok {
+		log.Error("Decode relay delivery ack failed")
+		return
+	}
+
+	clients := ws.SessionList.GetSessionsById(hex.EncodeToString(msg.SrcId))
+	if clients == nil {
+		log.Infof("Client Not Online: %x", msg.SrcId)
+		return
+	}
+
+	buf, err := proto.Marshal(msg.Ack)
+	if err != nil {
+		log.Errorf("Marshal delivery ack error: %v", err)
+		return
+	}
+
+	buf, err = proto.Marshal(&pb.ClientMessage{
+		MessageType: pb.DELIVERY_ACK,
+		Message:     buf,
+	})
+	if err != nil {
+		log.Errorf("Marshal client message error: %v", err)
+		return
+	}
+
+	for _, client := range clients {
+		if !client.IsClient() {
+			continue
+		}
+		err = client.SendBinary(buf)
+		if err != nil {
+			log.Error("Send to client error: ", err)
+		}
 	}
 }
 
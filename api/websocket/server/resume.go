@@ -0,0 +1,165 @@
+package server
+
+import (
+	"crypto/subtle"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/nknorg/nkn/api/websocket/session"
+	"github.com/nknorg/nkn/crypto/util"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/util/log"
+)
+
+const (
+	resumeTokenSize = 32
+)
+
+// detachedSession is the state of a client session whose connection is closed
+// but can still be resumed with resume token within grace period. Messages to
+// the client are kept in message buffer until the session is resumed or
+// expired.
+type detachedSession struct {
+	session  *session.Session
+	pubKey   []byte
+	token    []byte
+	resuming bool
+}
+
+type resumableSessions struct {
+	sync.Mutex
+	sessions map[string]*detachedSession // key: client id hex
+}
+
+// newResumeToken generates a new resume token for a client session. Returns
+// nil if session resumption is disabled.
+func (ws *WsServer) newResumeToken(s *session.Session) []byte {
+	if config.Parameters.WsResumeGracePeriod == 0 {
+		return nil
+	}
+	token := util.RandomBytes(resumeTokenSize)
+	s.SetResumeToken(token)
+	return token
+}
+
+// detachSession keeps the state of a closing client session so that client can
+// resume it within grace period.
+func (ws *WsServer) detachSession(s *session.Session) {
+	if config.Parameters.WsResumeGracePeriod == 0 || !s.IsClient() {
+		return
+	}
+
+	token := s.GetResumeToken()
+	if token == nil {
+		return
+	}
+
+	clientID := hex.EncodeToString(s.GetID())
+	if len(ws.SessionList.GetSessionsById(clientID)) > 1 {
+		return
+	}
+
+	ds := &detachedSession{
+		session: s,
+		pubKey:  s.GetPubKey(),
+		token:   token,
+	}
+
+	ws.resumable.Lock()
+	if old, ok := ws.resumable.sessions[clientID]; ok && old.resuming {
+		ws.resumable.Unlock()
+		return
+	}
+	ws.resumable.sessions[clientID] = ds
+	ws.resumable.Unlock()
+
+	time.AfterFunc(config.Parameters.WsResumeGracePeriod*time.Second, func() {
+		ws.expireDetachedSession(clientID, ds)
+	})
+}
+
+// expireDetachedSession removes a detached session that is not resumed within
+// grace period. Its messages are left in message buffer.
+func (ws *WsServer) expireDetachedSession(clientID string, ds *detachedSession) {
+	ws.resumable.Lock()
+	defer ws.resumable.Unlock()
+
+	if ws.resumable.sessions[clientID] != ds || ds.resuming {
+		return
+	}
+	delete(ws.resumable.sessions, clientID)
+}
+
+// discardDetachedSession removes the detached session of a client that sets
+// up a new session without resuming. Its messages are left in message buffer.
+func (ws *WsServer) discardDetachedSession(clientID string) {
+	ws.resumable.Lock()
+	defer ws.resumable.Unlock()
+
+	ds, ok := ws.resumable.sessions[clientID]
+	if !ok || ds.resuming {
+		return
+	}
+	delete(ws.resumable.sessions, clientID)
+}
+
+// queueDetachedMessage adds relay message to message buffer if its
+// destination has a detached or resuming session, so that it is subject to
+// the same limits as other buffered messages and is delivered in order after
+// session is resumed. Returns whether destination has such a session, and
+// whether message is buffered.
+func (ws *WsServer) queueDetachedMessage(relayMessage *pb.Relay) (bool, bool) {
+	ws.resumable.Lock()
+	defer ws.resumable.Unlock()
+
+	if _, ok := ws.resumable.sessions[hex.EncodeToString(relayMessage.DestId)]; !ok {
+		return false, false
+	}
+
+	return true, ws.messageBuffer.AddMessage(relayMessage.DestId, relayMessage)
+}
+
+// startResume marks the detached session of client as resuming if token and
+// pubKey match it. Messages keep being queued until finishResume is called.
+func (ws *WsServer) startResume(clientID string, pubKey, token []byte) (*detachedSession, bool) {
+	ws.resumable.Lock()
+	defer ws.resumable.Unlock()
+
+	ds, ok := ws.resumable.sessions[clientID]
+	if !ok || ds.resuming {
+		return nil, false
+	}
+
+	if subtle.ConstantTimeCompare(ds.pubKey, pubKey) != 1 || subtle.ConstantTimeCompare(ds.token, token) != 1 {
+		return nil, false
+	}
+
+	ds.resuming = true
+
+	return ds, true
+}
+
+// finishResume delivers all messages buffered during the gap to client in
+// order, then removes the detached session so that new messages are delivered
+// directly.
+func (ws *WsServer) finishResume(clientID string, ds *detachedSession) {
+	clientIDBytes := ds.session.GetID()
+	for {
+		ws.resumable.Lock()
+		messages := ws.messageBuffer.PopMessages(clientIDBytes)
+		if len(messages) == 0 {
+			delete(ws.resumable.sessions, clientID)
+			ws.resumable.Unlock()
+			break
+		}
+		ws.resumable.Unlock()
+
+		for _, msg := range messages {
+			ws.deliverInboundRelayMessage(msg)
+		}
+	}
+
+	log.Infof("Client %s resumed session", clientID)
+}
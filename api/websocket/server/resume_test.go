@@ -0,0 +1,71 @@
+package server
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/nknorg/nkn/api/websocket/messagebuffer"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/util/config"
+)
+
+func TestQueueDetachedMessage(t *testing.T) {
+	defer func(params config.Configuration) { config.Parameters = &params }(*config.Parameters)
+	config.Parameters.MsgBufferPersistent = false
+	config.Parameters.MsgBufferMaxMsgsPerClient = 10
+	config.Parameters.MsgBufferMaxSizePerSource = 0
+
+	msgSize := (&pb.Relay{DestId: []byte{1}, SrcPubkey: []byte{1}, Payload: make([]byte, 100), MaxHoldingSeconds: 60}).Size()
+	config.Parameters.MsgBufferMaxSizePerClient = uint32(2 * msgSize)
+
+	messageBuffer, err := messagebuffer.NewMessageBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ws := &WsServer{messageBuffer: messageBuffer}
+	ws.resumable.sessions = map[string]*detachedSession{
+		hex.EncodeToString([]byte{1}): {},
+		hex.EncodeToString([]byte{2}): {resuming: true},
+	}
+
+	tests := []struct {
+		name              string
+		destID            byte
+		payloadSize       int
+		maxHoldingSeconds uint32
+		detached          bool
+		buffered          bool
+	}{
+		{"no detached session", 3, 100, 60, false, false},
+		{"detached session", 1, 100, 60, true, true},
+		{"no holding time", 1, 100, 0, true, false},
+		{"larger than client limit", 1, 2 * msgSize, 60, true, false},
+		{"resuming session", 2, 100, 60, true, true},
+		{"second message of resuming session", 2, 100, 60, true, true},
+		{"resuming session evicts oldest message", 2, 100, 60, true, true},
+	}
+
+	for _, test := range tests {
+		msg := &pb.Relay{
+			DestId:            []byte{test.destID},
+			SrcPubkey:         []byte{1},
+			Payload:           make([]byte, test.payloadSize),
+			MaxHoldingSeconds: test.maxHoldingSeconds,
+		}
+		detached, buffered := ws.queueDetachedMessage(msg)
+		if detached != test.detached || buffered != test.buffered {
+			t.Errorf("%s: expect detached %v and buffered %v, got %v and %v", test.name, test.detached, test.buffered, detached, buffered)
+		}
+	}
+
+	if n := len(messageBuffer.PopMessages([]byte{1})); n != 1 {
+		t.Errorf("expect 1 buffered message of detached session, got %d", n)
+	}
+	if n := len(messageBuffer.PopMessages([]byte{2})); n != 2 {
+		t.Errorf("expect 2 buffered messages of resuming session, got %d", n)
+	}
+	if n := len(messageBuffer.PopMessages([]byte{3})); n != 0 {
+		t.Errorf("expect no buffered message without detached session, got %d", n)
+	}
+}
@@ -1,7 +1,9 @@
 package server
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
 	"encoding/hex"
 	"encoding/json"
@@ -17,6 +19,7 @@ import (
 	"github.com/nknorg/nkn/api/websocket/session"
 	"github.com/nknorg/nkn/chain"
 	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/common/serialization"
 	"github.com/nknorg/nkn/crypto"
 	"github.com/nknorg/nkn/event"
 	"github.com/nknorg/nkn/node"
@@ -52,19 +55,29 @@ type WsServer struct {
 	wallet        vault.Wallet
 	messageBuffer *messagebuffer.MessageBuffer
 	sigChainCache Cache
+	reassembler   *fragmentReassembler
+	resumable     resumableSessions
 }
 
-func InitWsServer(localNode *node.LocalNode, wallet vault.Wallet) *WsServer {
+func InitWsServer(localNode *node.LocalNode, wallet vault.Wallet) (*WsServer, error) {
+	messageBuffer, err := messagebuffer.NewMessageBuffer()
+	if err != nil {
+		return nil, err
+	}
+	messagebuffer.DefaultMessageBuffer = messageBuffer
+
 	ws := &WsServer{
 		Upgrader:      websocket.Upgrader{},
 		SessionList:   session.NewSessionList(),
 		TxHashMap:     make(map[string]string),
 		localNode:     localNode,
 		wallet:        wallet,
-		messageBuffer: messagebuffer.NewMessageBuffer(),
+		messageBuffer: messageBuffer,
 		sigChainCache: NewGoCache(sigChainCacheExpiration, sigChainCacheCleanupInterval),
 	}
-	return ws
+	ws.reassembler = newFragmentReassembler(ws.sendIncompleteAck)
+	ws.resumable.sessions = make(map[string]*detachedSession)
+	return ws, nil
 }
 
 func (ws *WsServer) Start() error {
@@ -95,6 +108,11 @@ func (ws *WsServer) Start() error {
 	}
 
 	event.Queue.Subscribe(event.SendInboundMessageToClient, ws.sendInboundRelayMessageToClient)
+	event.Queue.Subscribe(event.SendDeliveryAckToClient, ws.sendDeliveryAckToClient)
+	event.Queue.Subscribe(event.BlockPersistCompleted, ws.onBlockPersisted)
+	event.Queue.Subscribe(event.NewTransactionAdded, ws.onTxnAdded)
+	event.Queue.Subscribe(event.TransactionReplaced, ws.onTxnReplaced)
+	ws.localNode.GetTxnPool().SubscribeTxnStatus(ws.onTxnStatusChanged)
 
 	var done = make(chan bool)
 	go ws.checkSessionsTimeout(done)
@@ -145,7 +163,33 @@ func (ws *WsServer) registryMethod() {
 			return common.RespPacking(nil, common.INVALID_PARAMS)
 		}
 
-		// TODO: use signature (or better, with one-time challange) to verify identity
+		err = ws.localNode.CheckClientAccess(addrStr)
+		if err != nil {
+			log.Warningf("Reject client %s: %v", addrStr, err)
+			return common.RespPacking(nil, common.ACCESS_DENIED)
+		}
+
+		sessions := ws.SessionList.GetSessionsById(cmd["Userid"].(string))
+		if len(sessions) != 1 {
+			log.Error("Session not exists or more than one session exists")
+			return common.RespPacking(nil, common.INTERNAL_ERROR)
+		}
+
+		var resumeToken []byte
+		if tokenStr, ok := cmd["ResumeToken"].(string); ok {
+			if cmd["Signature"] == nil {
+				return common.RespPacking(nil, common.INVALID_SIGNATURE)
+			}
+			resumeToken, err = hex.DecodeString(tokenStr)
+			if err != nil {
+				return common.RespPacking(nil, common.INVALID_PARAMS)
+			}
+		}
+
+		errCode := ws.verifyClientSignature(sessions[0], pubKey, cmd["Signature"])
+		if errCode != common.SUCCESS {
+			return common.RespPacking(nil, errCode)
+		}
 
 		localNode, err := s.GetNetNode()
 		if err != nil {
@@ -163,14 +207,33 @@ func (ws *WsServer) registryMethod() {
 		}
 
 		newSessionID := hex.EncodeToString(clientID)
+		var detached *detachedSession
+		if resumeToken != nil {
+			detached, ok = ws.startResume(newSessionID, pubKey, resumeToken)
+			if !ok {
+				return common.RespPacking(nil, common.INVALID_TOKEN)
+			}
+		} else {
+			ws.discardDetachedSession(newSessionID)
+		}
+
 		session, err := ws.SessionList.ChangeSessionToClient(cmd["Userid"].(string), newSessionID)
 		if err != nil {
 			log.Error("Change session id error: ", err)
+			if detached != nil {
+				go ws.finishResume(newSessionID, detached)
+			}
 			return common.RespPacking(nil, common.INTERNAL_ERROR)
 		}
 		session.SetClient(clientID, pubKey, &addrStr)
+		if detached != nil {
+			session.CopySubscriptions(detached.session)
+		}
 
 		go func() {
+			if detached != nil {
+				ws.finishResume(newSessionID, detached)
+			}
 			messages := ws.messageBuffer.PopMessages(clientID)
 			for _, message := range messages {
 				ws.sendInboundRelayMessage(message)
@@ -189,6 +252,10 @@ func (ws *WsServer) registryMethod() {
 		res := make(map[string]interface{})
 		res["node"] = common.NodeInfo(addr, pubkey, id)
 		res["sigChainBlockHash"] = BytesToHexString(sigChainBlockHash.ToArray())
+		res["resumed"] = detached != nil
+		if token := ws.newResumeToken(session); token != nil {
+			res["resumeToken"] = hex.EncodeToString(token)
+		}
 
 		return common.RespPacking(res, common.SUCCESS)
 	}
@@ -198,6 +265,9 @@ func (ws *WsServer) registryMethod() {
 		"gettxhashmap":    {handler: gettxhashmap},
 		"getsessioncount": {handler: getsessioncount},
 		"setClient":       {handler: setClient},
+		"subscribe":       {handler: ws.subscribe},
+		"unsubscribe":     {handler: ws.unsubscribe},
+		"getTopicDests":   {handler: ws.getTopicDests},
 	}
 
 	for name, handler := range common.InitialAPIHandlers {
@@ -265,8 +335,11 @@ func (ws *WsServer) websocketHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	ws.sendAuthChallenge(nsSession, nsSession.GetChallenge())
+
 	defer func() {
 		ws.deleteTxHashs(nsSession.GetSessionId())
+		ws.detachSession(nsSession)
 		ws.SessionList.CloseSession(nsSession)
 		if err := recover(); err != nil {
 			log.Error("websocket recover:", err)
@@ -286,6 +359,78 @@ func (ws *WsServer) websocketHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// setClientSigningPrefix is prepended to the message signed in setClient so
+// that the signature can not be used as signature of any other message.
+const setClientSigningPrefix = "nkn-ws-setclient"
+
+// getSetClientHashForSigning returns the hash that client should sign in
+// setClient. The challenge is bound to public key and websocket address of the
+// node, so a node can neither use the challenge to get client signature of an
+// arbitrary message, nor reuse a signature it relayed from another node.
+func getSetClientHashForSigning(nodePubKey []byte, wsAddr string, challenge []byte) []byte {
+	buf := bytes.NewBufferString(setClientSigningPrefix)
+	serialization.WriteVarBytes(buf, nodePubKey)
+	serialization.WriteVarString(buf, wsAddr)
+	serialization.WriteVarBytes(buf, challenge)
+	hash := sha256.Sum256(buf.Bytes())
+	return hash[:]
+}
+
+// sendAuthChallenge pushes the challenge that client needs to sign in setClient
+// to the session, together with node public key and websocket address that are
+// also included in the signed message.
+func (ws *WsServer) sendAuthChallenge(session *session.Session, challenge []byte) {
+	resp := common.ResponsePack(common.SUCCESS)
+	resp["Action"] = "authChallenge"
+	resp["Result"] = map[string]interface{}{
+		"challenge":  hex.EncodeToString(challenge),
+		"nodePubKey": hex.EncodeToString(ws.localNode.GetPubKey().EncodePoint()),
+		"addr":       ws.localNode.GetWsAddr(),
+	}
+	ws.respondToSession(session, resp)
+}
+
+// verifyClientSignature checks the signature of the session challenge signed
+// by client private key as in getSetClientHashForSigning. The challenge is
+// consumed and a new one is pushed to the session regardless of the result.
+// Missing signature is only allowed before client auth is required.
+func (ws *WsServer) verifyClientSignature(session *session.Session, pubKey []byte, sig interface{}) common.ErrCode {
+	challenge := session.ResetChallenge()
+	defer ws.sendAuthChallenge(session, session.GetChallenge())
+
+	if sig == nil {
+		if config.Parameters.WsClientAuthRequired || config.RequireWsClientAuth.GetValueAtHeight(chain.DefaultLedger.Store.GetHeight()) {
+			log.Warning("Reject setClient without signature")
+			return common.INVALID_SIGNATURE
+		}
+		return common.SUCCESS
+	}
+
+	sigStr, ok := sig.(string)
+	if !ok {
+		return common.INVALID_PARAMS
+	}
+
+	signature, err := hex.DecodeString(sigStr)
+	if err != nil {
+		return common.INVALID_PARAMS
+	}
+
+	pk, err := crypto.DecodePoint(pubKey)
+	if err != nil {
+		return common.INVALID_PARAMS
+	}
+
+	hash := getSetClientHashForSigning(ws.localNode.GetPubKey().EncodePoint(), ws.localNode.GetWsAddr(), challenge)
+	err = crypto.Verify(*pk, hash, signature)
+	if err != nil {
+		log.Warningf("Verify client signature error: %v", err)
+		return common.INVALID_SIGNATURE
+	}
+
+	return common.SUCCESS
+}
+
 func (ws *WsServer) IsValidMsg(reqMsg map[string]interface{}) bool {
 	if _, ok := reqMsg["Hash"].(string); !ok && reqMsg["Hash"] != nil {
 		return false
@@ -316,7 +461,7 @@ func (ws *WsServer) OnDataHandle(curSession *session.Session, messageType int, b
 				log.Errorf("Unmarshal outbound message error: %v", err)
 				return false
 			}
-			ws.sendOutboundRelayMessage(curSession.GetAddrStr(), outboundMsg)
+			ws.sendOutboundRelayMessage(curSession, outboundMsg)
 		case pb.RECEIPT:
 			receipt := &pb.Receipt{}
 			err = proto.Unmarshal(msg.Message, receipt)
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/nknorg/nkn/crypto"
+)
+
+func TestGetSetClientHashForSigning(t *testing.T) {
+	nodePubKey := []byte{1, 2, 3}
+	wsAddr := "127.0.0.1:30002"
+	challenge := []byte{4, 5, 6}
+	hash := getSetClientHashForSigning(nodePubKey, wsAddr, challenge)
+
+	tests := []struct {
+		name       string
+		nodePubKey []byte
+		wsAddr     string
+		challenge  []byte
+	}{
+		{"other node public key", []byte{1, 2, 4}, wsAddr, challenge},
+		{"other node address", nodePubKey, "127.0.0.1:30003", challenge},
+		{"other challenge", nodePubKey, wsAddr, []byte{4, 5, 7}},
+		{"field boundary moved", []byte{1, 2}, wsAddr, append([]byte{3}, challenge...)},
+		{"no node info", nil, "", challenge},
+	}
+
+	for _, test := range tests {
+		if bytes.Equal(getSetClientHashForSigning(test.nodePubKey, test.wsAddr, test.challenge), hash) {
+			t.Errorf("%s: expect different hash", test.name)
+		}
+	}
+
+	// a node choosing the hash of another message as challenge should not get
+	// a valid signature of that message
+	privateKey, publicKey, err := crypto.GenKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := sha256.Sum256([]byte("message"))
+	signature, err := crypto.Sign(privateKey, getSetClientHashForSigning(nodePubKey, wsAddr, message[:]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = crypto.Verify(publicKey, message[:], signature); err == nil {
+		t.Error("expect signature of challenge not to be valid for challenge itself")
+	}
+}
@@ -0,0 +1,360 @@
+package server
+
+import (
+	"encoding/json"
+
+	"github.com/nknorg/nkn/api/common"
+	"github.com/nknorg/nkn/api/websocket/session"
+	"github.com/nknorg/nkn/block"
+	"github.com/nknorg/nkn/chain/pool"
+	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/crypto"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/program"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/address"
+	"github.com/nknorg/nkn/util/log"
+)
+
+// Topics that a websocket session can subscribe to
+const (
+	// TopicBlock pushes new blocks, key is not used
+	TopicBlock = "block"
+	// TopicAddress pushes transactions in new blocks touching address key
+	TopicAddress = "address"
+	// TopicTxPool pushes transactions admitted to txpool touching address key
+	TopicTxPool = "txpool"
+	// TopicName pushes name changes in new blocks, key is name or empty for
+	// all names
+	TopicName = "name"
+	// TopicSubscribers pushes subscriber changes of pubsub topic key in new
+	// blocks
+	TopicSubscribers = "subscribers"
+	// TopicTxStatus pushes lifecycle status changes of transaction with hash key
+	TopicTxStatus = "txstatus"
+)
+
+// subscribe subscribes the session to events of a topic
+// params: {"Topic":<topic>, "Key":<key>, "Full":<push full block>}
+// return: {"resultOrData":<result>|<error data>, "error":<errcode>}
+func (ws *WsServer) subscribe(s common.Serverer, cmd map[string]interface{}) map[string]interface{} {
+	topic, ok := cmd["Topic"].(string)
+	if !ok {
+		return common.RespPacking(nil, common.INVALID_PARAMS)
+	}
+
+	key, _ := cmd["Key"].(string)
+	var options interface{}
+	switch topic {
+	case TopicBlock:
+		key = ""
+		options, _ = cmd["Full"].(bool)
+	case TopicAddress, TopicTxPool:
+		if _, err := ToScriptHash(key); err != nil {
+			return common.RespPacking(nil, common.INVALID_PARAMS)
+		}
+	case TopicName:
+	case TopicSubscribers:
+		if len(key) == 0 {
+			return common.RespPacking(nil, common.INVALID_PARAMS)
+		}
+	case TopicTxStatus:
+		b, err := HexStringToBytes(key)
+		if err != nil {
+			return common.RespPacking(nil, common.INVALID_PARAMS)
+		}
+		hash, err := Uint256ParseFromBytes(b)
+		if err != nil {
+			return common.RespPacking(nil, common.INVALID_PARAMS)
+		}
+		key = hash.ToHexString()
+	default:
+		return common.RespPacking(nil, common.INVALID_PARAMS)
+	}
+
+	sessions := ws.SessionList.GetSessionsById(cmd["Userid"].(string))
+	if len(sessions) != 1 {
+		log.Error("Session not exists or more than one session exists")
+		return common.RespPacking(nil, common.INTERNAL_ERROR)
+	}
+
+	err := sessions[0].AddSubscription(topic, key, options)
+	if err != nil {
+		return common.RespPacking(err.Error(), common.INVALID_PARAMS)
+	}
+
+	return common.RespPacking(sessions[0].GetSubscriptions(), common.SUCCESS)
+}
+
+// unsubscribe unsubscribes the session from events of a topic. Empty key
+// removes all subscriptions of the topic.
+// params: {"Topic":<topic>, "Key":<key>}
+// return: {"resultOrData":<result>|<error data>, "error":<errcode>}
+func (ws *WsServer) unsubscribe(s common.Serverer, cmd map[string]interface{}) map[string]interface{} {
+	topic, ok := cmd["Topic"].(string)
+	if !ok {
+		return common.RespPacking(nil, common.INVALID_PARAMS)
+	}
+
+	key, _ := cmd["Key"].(string)
+
+	sessions := ws.SessionList.GetSessionsById(cmd["Userid"].(string))
+	if len(sessions) != 1 {
+		log.Error("Session not exists or more than one session exists")
+		return common.RespPacking(nil, common.INTERNAL_ERROR)
+	}
+
+	if !sessions[0].RemoveSubscription(topic, key) {
+		return common.RespPacking(nil, common.INVALID_PARAMS)
+	}
+
+	return common.RespPacking(sessions[0].GetSubscriptions(), common.SUCCESS)
+}
+
+// pushToSubscribers pushes result to all sessions subscribed to topic with key
+func (ws *WsServer) pushToSubscribers(topic, key, action string, result interface{}) {
+	resp := common.ResponsePack(common.SUCCESS)
+	resp["Action"] = action
+	resp["Result"] = result
+	ws.SessionList.ForEachSession(func(s *session.Session) {
+		if _, ok := s.GetSubscription(topic, key); ok {
+			ws.respondToSession(s, resp)
+		}
+	})
+}
+
+func getTxnInfo(txn *transaction.Transaction) interface{} {
+	info, err := txn.GetInfo()
+	if err != nil {
+		log.Warningf("Get txn info error: %v", err)
+		return nil
+	}
+	var x interface{}
+	json.Unmarshal(info, &x)
+	return x
+}
+
+func pubKeyToAddress(pubKey []byte) (string, error) {
+	pk, err := crypto.NewPubKeyFromBytes(pubKey)
+	if err != nil {
+		return "", err
+	}
+	programHash, err := program.CreateProgramHash(pk)
+	if err != nil {
+		return "", err
+	}
+	return programHash.ToAddress()
+}
+
+// getTxnAddresses returns addresses of sender and recipient of a transaction
+func getTxnAddresses(txn *transaction.Transaction) []string {
+	programHashes, err := txn.GetProgramHashes()
+	if err != nil {
+		return nil
+	}
+
+	payload, err := transaction.Unpack(txn.UnsignedTx.Payload)
+	if err != nil {
+		return nil
+	}
+
+	var recipient []byte
+	switch p := payload.(type) {
+	case *pb.Coinbase:
+		recipient = p.Recipient
+	case *pb.TransferAsset:
+		recipient = p.Recipient
+	case *pb.NanoPay:
+		recipient = p.Recipient
+	}
+	if len(recipient) > 0 {
+		programHashes = append(programHashes, BytesToUint160(recipient))
+	}
+
+	addrs := make([]string, 0, len(programHashes)+1)
+	for _, programHash := range programHashes {
+		addr, err := programHash.ToAddress()
+		if err != nil {
+			continue
+		}
+		addrs = append(addrs, addr)
+	}
+
+	if p, ok := payload.(*pb.TransferName); ok {
+		if addr, err := pubKeyToAddress(p.Recipient); err == nil {
+			addrs = append(addrs, addr)
+		}
+	}
+
+	return addrs
+}
+
+func (ws *WsServer) pushTxnToAddressSubscribers(topic, action string, txn *transaction.Transaction, blk *block.Block) {
+	pushed := make(map[string]bool)
+	for _, addr := range getTxnAddresses(txn) {
+		if pushed[addr] {
+			continue
+		}
+		pushed[addr] = true
+
+		result := map[string]interface{}{
+			"address":     addr,
+			"transaction": getTxnInfo(txn),
+		}
+		if blk != nil {
+			hash := blk.Hash()
+			result["height"] = blk.Header.UnsignedHeader.Height
+			result["blockHash"] = hash.ToHexString()
+		}
+		ws.pushToSubscribers(topic, addr, action, result)
+	}
+}
+
+func (ws *WsServer) pushNameChange(txn *transaction.Transaction, payload interface{}, height uint32) {
+	hash := txn.Hash()
+	result := map[string]interface{}{
+		"txType": txn.UnsignedTx.Payload.Type.String(),
+		"height": height,
+		"txHash": hash.ToHexString(),
+	}
+
+	var name string
+	switch p := payload.(type) {
+	case *pb.RegisterName:
+		name = p.Name
+		result["registrant"] = BytesToHexString(p.Registrant)
+	case *pb.TransferName:
+		name = p.Name
+		result["registrant"] = BytesToHexString(p.Registrant)
+		result["recipient"] = BytesToHexString(p.Recipient)
+	case *pb.DeleteName:
+		name = p.Name
+		result["registrant"] = BytesToHexString(p.Registrant)
+	default:
+		return
+	}
+	result["name"] = name
+
+	ws.pushToSubscribers(TopicName, name, "nameChange", result)
+}
+
+func (ws *WsServer) pushSubscriberChange(txn *transaction.Transaction, payload interface{}, height uint32) {
+	hash := txn.Hash()
+	result := map[string]interface{}{
+		"txType": txn.UnsignedTx.Payload.Type.String(),
+		"height": height,
+		"txHash": hash.ToHexString(),
+	}
+
+	var topic string
+	switch p := payload.(type) {
+	case *pb.Subscribe:
+		topic = p.Topic
+		result["subscriber"] = address.AssembleClientAddress(p.Identifier, p.Subscriber)
+		result["duration"] = p.Duration
+		result["meta"] = p.Meta
+	case *pb.Unsubscribe:
+		topic = p.Topic
+		result["subscriber"] = address.AssembleClientAddress(p.Identifier, p.Subscriber)
+	default:
+		return
+	}
+	result["topic"] = topic
+
+	ws.pushToSubscribers(TopicSubscribers, topic, "subscriberChange", result)
+}
+
+func (ws *WsServer) pushBlockToSubscribers(blk *block.Block) {
+	var header, full interface{}
+	ws.SessionList.ForEachSession(func(s *session.Session) {
+		options, ok := s.GetSubscription(TopicBlock, "")
+		if !ok {
+			return
+		}
+
+		var result interface{}
+		if isFull, _ := options.(bool); isFull {
+			if full == nil {
+				info, _ := blk.GetInfo()
+				json.Unmarshal(info, &full)
+			}
+			result = full
+		} else {
+			if header == nil {
+				info, _ := blk.Header.GetInfo()
+				json.Unmarshal(info, &header)
+			}
+			result = header
+		}
+
+		resp := common.ResponsePack(common.SUCCESS)
+		resp["Action"] = "block"
+		resp["Result"] = result
+		ws.respondToSession(s, resp)
+	})
+}
+
+// onBlockPersisted pushes events in a new block to subscribed sessions
+func (ws *WsServer) onBlockPersisted(v interface{}) {
+	blk, ok := v.(*block.Block)
+	if !ok {
+		log.Error("Decode block failed")
+		return
+	}
+
+	ws.pushBlockToSubscribers(blk)
+
+	height := blk.Header.UnsignedHeader.Height
+	for _, txn := range blk.Transactions {
+		ws.pushTxnToAddressSubscribers(TopicAddress, "addressTransaction", txn, blk)
+
+		payload, err := transaction.Unpack(txn.UnsignedTx.Payload)
+		if err != nil {
+			continue
+		}
+
+		switch txn.UnsignedTx.Payload.Type {
+		case pb.REGISTER_NAME_TYPE, pb.TRANSFER_NAME_TYPE, pb.DELETE_NAME_TYPE:
+			ws.pushNameChange(txn, payload, height)
+		case pb.SUBSCRIBE_TYPE, pb.UNSUBSCRIBE_TYPE:
+			ws.pushSubscriberChange(txn, payload, height)
+		}
+	}
+}
+
+// onTxnAdded pushes a transaction admitted to txpool to subscribed sessions
+func (ws *WsServer) onTxnAdded(v interface{}) {
+	txn, ok := v.(*transaction.Transaction)
+	if !ok {
+		log.Error("Decode transaction failed")
+		return
+	}
+
+	ws.pushTxnToAddressSubscribers(TopicTxPool, "txPoolTransaction", txn, nil)
+}
+
+// onTxnReplaced pushes a transaction in txpool that is replaced by another one
+// to subscribed sessions
+func (ws *WsServer) onTxnReplaced(v interface{}) {
+	replacement, ok := v.(*pool.TxnReplacement)
+	if !ok {
+		log.Error("Decode transaction replacement failed")
+		return
+	}
+
+	oldHash := replacement.OldTxn.Hash()
+	newHash := replacement.NewTxn.Hash()
+	for _, addr := range getTxnAddresses(replacement.OldTxn) {
+		ws.pushToSubscribers(TopicTxPool, addr, "txPoolTransactionReplaced", map[string]interface{}{
+			"address":    addr,
+			"oldTxnHash": oldHash.ToHexString(),
+			"newTxnHash": newHash.ToHexString(),
+		})
+	}
+}
+
+// onTxnStatusChanged pushes a status change of a transaction to subscribed
+// sessions
+func (ws *WsServer) onTxnStatusChanged(update *pool.TxnStatusUpdate) {
+	ws.pushToSubscribers(TopicTxStatus, update.TxnHash, "transactionStatus", update)
+}
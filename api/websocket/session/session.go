@@ -2,10 +2,12 @@ package session
 
 import (
 	"errors"
+	"net"
 	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/nknorg/nkn/crypto/util"
 	"github.com/pborman/uuid"
 )
 
@@ -17,9 +19,22 @@ type Session struct {
 	clientChordID []byte
 	clientPubKey  []byte
 	clientAddrStr *string
+	challenge     []byte
+	remoteIP      string
+	resumeToken   []byte
+
+	subLock       sync.RWMutex
+	subscriptions map[string]map[string]interface{} // topic -> key -> options
 }
 
-const sessionTimeOut int64 = 120
+const (
+	sessionTimeOut int64 = 120
+	challengeSize        = 32
+
+	MaxSubscriptionsPerSession = 256
+)
+
+var ErrTooManySubscriptions = errors.New("too many subscriptions")
 
 func (s *Session) GetSessionId() string {
 	return s.sSessionId
@@ -27,10 +42,18 @@ func (s *Session) GetSessionId() string {
 
 func newSession(wsConn *websocket.Conn) (session *Session, err error) {
 	sSessionId := uuid.NewUUID().String()
+	remoteIP, _, err := net.SplitHostPort(wsConn.RemoteAddr().String())
+	if err != nil {
+		remoteIP = wsConn.RemoteAddr().String()
+	}
 	session = &Session{
 		mConnection: wsConn,
 		nLastActive: time.Now().Unix(),
 		sSessionId:  sSessionId,
+		challenge:   util.RandomBytes(challengeSize),
+		remoteIP:    remoteIP,
+
+		subscriptions: make(map[string]map[string]interface{}),
 	}
 	return session, nil
 }
@@ -117,3 +140,135 @@ func (s *Session) GetAddrStr() *string {
 	}
 	return s.clientAddrStr
 }
+
+// GetRemoteIP returns the ip address of remote side of the session
+func (s *Session) GetRemoteIP() string {
+	return s.remoteIP
+}
+
+// GetChallenge returns the current challenge that client should sign to prove
+// its identity.
+func (s *Session) GetChallenge() []byte {
+	s.Lock()
+	defer s.Unlock()
+	return s.challenge
+}
+
+// ResetChallenge replaces the current challenge with a new random one and
+// returns the old challenge, so that each challenge can only be used once.
+func (s *Session) ResetChallenge() []byte {
+	s.Lock()
+	defer s.Unlock()
+	challenge := s.challenge
+	s.challenge = util.RandomBytes(challengeSize)
+	return challenge
+}
+
+// GetResumeToken returns the token that client can use to resume the session
+// after reconnect.
+func (s *Session) GetResumeToken() []byte {
+	s.Lock()
+	defer s.Unlock()
+	return s.resumeToken
+}
+
+// SetResumeToken sets the token that client can use to resume the session
+// after reconnect.
+func (s *Session) SetResumeToken(token []byte) {
+	s.Lock()
+	defer s.Unlock()
+	s.resumeToken = token
+}
+
+// CopySubscriptions replaces subscriptions of the session with the ones of
+// another session.
+func (s *Session) CopySubscriptions(other *Session) {
+	other.subLock.RLock()
+	subscriptions := make(map[string]map[string]interface{}, len(other.subscriptions))
+	for topic, keys := range other.subscriptions {
+		subscriptions[topic] = make(map[string]interface{}, len(keys))
+		for key, options := range keys {
+			subscriptions[topic][key] = options
+		}
+	}
+	other.subLock.RUnlock()
+
+	s.subLock.Lock()
+	s.subscriptions = subscriptions
+	s.subLock.Unlock()
+}
+
+// AddSubscription subscribes the session to events of topic with key, and
+// replaces options if already subscribed. Empty key matches all events of
+// topic.
+func (s *Session) AddSubscription(topic, key string, options interface{}) error {
+	s.subLock.Lock()
+	defer s.subLock.Unlock()
+	if _, ok := s.subscriptions[topic][key]; !ok {
+		numSubs := 0
+		for _, keys := range s.subscriptions {
+			numSubs += len(keys)
+		}
+		if numSubs >= MaxSubscriptionsPerSession {
+			return ErrTooManySubscriptions
+		}
+	}
+	if _, ok := s.subscriptions[topic]; !ok {
+		s.subscriptions[topic] = make(map[string]interface{})
+	}
+	s.subscriptions[topic][key] = options
+	return nil
+}
+
+// RemoveSubscription unsubscribes the session from events of topic with key,
+// or all keys of topic if key is empty. Returns false if not subscribed.
+func (s *Session) RemoveSubscription(topic, key string) bool {
+	s.subLock.Lock()
+	defer s.subLock.Unlock()
+	keys, ok := s.subscriptions[topic]
+	if !ok {
+		return false
+	}
+	if len(key) == 0 {
+		delete(s.subscriptions, topic)
+		return true
+	}
+	if _, ok := keys[key]; !ok {
+		return false
+	}
+	delete(keys, key)
+	if len(keys) == 0 {
+		delete(s.subscriptions, topic)
+	}
+	return true
+}
+
+// GetSubscription returns the options of subscription and whether the session
+// is subscribed to events of topic with key, either explicitly or by empty
+// key.
+func (s *Session) GetSubscription(topic, key string) (interface{}, bool) {
+	s.subLock.RLock()
+	defer s.subLock.RUnlock()
+	keys, ok := s.subscriptions[topic]
+	if !ok {
+		return nil, false
+	}
+	if options, ok := keys[key]; ok {
+		return options, true
+	}
+	options, ok := keys[""]
+	return options, ok
+}
+
+// GetSubscriptions returns subscribed keys of each topic
+func (s *Session) GetSubscriptions() map[string][]string {
+	s.subLock.RLock()
+	defer s.subLock.RUnlock()
+	subs := make(map[string][]string, len(s.subscriptions))
+	for topic, keys := range s.subscriptions {
+		for key := range keys {
+			subs[topic] = append(subs[topic], key)
+		}
+	}
+	return subs
+}
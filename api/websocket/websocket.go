@@ -23,11 +23,15 @@ var (
 	pushBlockTxsFlag bool = false
 )
 
-func NewServer(localNode *node.LocalNode, w vault.Wallet) *server.WsServer {
+func NewServer(localNode *node.LocalNode, w vault.Wallet) (*server.WsServer, error) {
 	//	common.SetNode(n)
+	var err error
+	ws, err = server.InitWsServer(localNode, w)
+	if err != nil {
+		return nil, err
+	}
 	event.Queue.Subscribe(event.NewBlockProduced, SendBlock2WSclient)
-	ws = server.InitWsServer(localNode, w)
-	return ws
+	return ws, nil
 }
 
 func SendBlock2WSclient(v interface{}) {
@@ -175,8 +175,31 @@ func TransactionCheck(ctx context.Context, block *block.Block) error {
 	return nil
 }
 
+// TransactionsRootCheck checks if transactions of a block match the
+// transactions root in its header. Block hash only covers block header, so
+// this is needed before trusting transactions of a block with known hash.
+func TransactionsRootCheck(b *block.Block) error {
+	txnsHash := make([]Uint256, len(b.Transactions))
+	for i, txn := range b.Transactions {
+		txnsHash[i] = txn.Hash()
+	}
+
+	txnsRoot, err := crypto.ComputeRoot(txnsHash)
+	if err != nil {
+		return fmt.Errorf("compute txns root error: %v", err)
+	}
+	if !bytes.Equal(txnsRoot.ToArray(), b.Header.UnsignedHeader.TransactionsRoot) {
+		return fmt.Errorf("computed txn root %x is different from txn root in header %x", txnsRoot.ToArray(), b.Header.UnsignedHeader.TransactionsRoot)
+	}
+
+	return nil
+}
+
 // GetNextBlockSigner gets the next block signer after block height at
-// timestamp. Returns next signer's public key, chord ID, winner type, and error
+// timestamp. Proposer of a timed out block is also counted back from height
+// rather than current ledger height, so blocks saved in ledger can be verified
+// against their previous height. Returns next signer's public key, chord ID,
+// winner type, and error
 func GetNextBlockSigner(height uint32, timestamp int64) ([]byte, []byte, pb.WinnerType, error) {
 	currentHeight := DefaultLedger.Store.GetHeight()
 	if height > currentHeight {
@@ -226,7 +249,7 @@ func GetNextBlockSigner(height uint32, timestamp int64) ([]byte, []byte, pb.Winn
 
 		winnerType = pb.BLOCK_SIGNER
 
-		proposerBlockHeight := int64(DefaultLedger.Store.GetHeight()) - timeSinceLastBlock/proposerChangeTime
+		proposerBlockHeight := int64(height) - timeSinceLastBlock/proposerChangeTime
 		if proposerBlockHeight < 0 {
 			proposerBlockHeight = 0
 		}
@@ -298,21 +321,25 @@ func GetNextMiningSigChainTxnHash(height uint32) (Uint256, pb.WinnerType, error)
 	return nextMiningSigChainTxnHash, pb.TXN_SIGNER, nil
 }
 
+// SignerCheck checks if header is signed by the expected signer of the block
+// after current block, and signer ID matches the one registered in ledger.
 func SignerCheck(header *block.Header) error {
 	currentHeight := DefaultLedger.Store.GetHeight()
-	publicKey, chordID, _, err := GetNextBlockSigner(currentHeight, header.UnsignedHeader.Timestamp)
+	prevHeader, err := DefaultLedger.Store.GetHeaderByHeight(currentHeight)
 	if err != nil {
-		return fmt.Errorf("get next block signer error: %v", err)
+		return fmt.Errorf("get header at height %d error: %v", currentHeight, err)
 	}
 
-	if !bytes.Equal(header.UnsignedHeader.SignerPk, publicKey) {
-		return fmt.Errorf("invalid block signer public key %x, should be %x", header.UnsignedHeader.SignerPk, publicKey)
-	}
+	return signerCheck(header, prevHeader, currentHeight)
+}
 
-	if len(chordID) > 0 && !bytes.Equal(header.UnsignedHeader.SignerId, chordID) {
-		return fmt.Errorf("invalid block signer chord ID %x, should be %x", header.UnsignedHeader.SignerId, chordID)
+func signerCheck(header, prevHeader *block.Header, prevHeight uint32) error {
+	err := verifyHeaderSigner(header, prevHeader, prevHeight)
+	if err != nil {
+		return err
 	}
 
+	publicKey := header.UnsignedHeader.SignerPk
 	id, err := DefaultLedger.Store.GetID(publicKey)
 	if err != nil {
 		return fmt.Errorf("get ID of signer %x error: %v", publicKey, err)
@@ -324,6 +351,31 @@ func SignerCheck(header *block.Header) error {
 		return fmt.Errorf("ID of signer %x should be %x, got %x", publicKey, id, header.UnsignedHeader.SignerId)
 	}
 
+	return nil
+}
+
+// verifyHeaderSigner checks timestamp, signer, signature and random beacon of
+// header against prevHeader, which is the header at height prevHeight. It only
+// depends on blocks up to prevHeight, so it can be used to verify both new
+// blocks and blocks already saved in ledger.
+func verifyHeaderSigner(header, prevHeader *block.Header, prevHeight uint32) error {
+	if prevHeader.UnsignedHeader.Timestamp >= header.UnsignedHeader.Timestamp {
+		return fmt.Errorf("header timestamp %d is not greater than prev timestamp %d", header.UnsignedHeader.Timestamp, prevHeader.UnsignedHeader.Timestamp)
+	}
+
+	publicKey, chordID, _, err := GetNextBlockSigner(prevHeight, header.UnsignedHeader.Timestamp)
+	if err != nil {
+		return fmt.Errorf("get next block signer error: %v", err)
+	}
+
+	if !bytes.Equal(header.UnsignedHeader.SignerPk, publicKey) {
+		return fmt.Errorf("invalid block signer public key %x, should be %x", header.UnsignedHeader.SignerPk, publicKey)
+	}
+
+	if len(chordID) > 0 && !bytes.Equal(header.UnsignedHeader.SignerId, chordID) {
+		return fmt.Errorf("invalid block signer chord ID %x, should be %x", header.UnsignedHeader.SignerId, chordID)
+	}
+
 	rawPubKey, err := crypto.DecodePoint(publicKey)
 	if err != nil {
 		return fmt.Errorf("decode public key error: %v", err)
@@ -333,6 +385,17 @@ func SignerCheck(header *block.Header) error {
 		return fmt.Errorf("invalid header signature %x: %v", header.Signature, err)
 	}
 
+	if len(header.UnsignedHeader.RandomBeacon) != config.RandomBeaconLength {
+		return fmt.Errorf("invalid header RandomBeacon length %d, expecting %d", len(header.UnsignedHeader.RandomBeacon), config.RandomBeaconLength)
+	}
+
+	vrf := header.UnsignedHeader.RandomBeacon[:config.RandomBeaconUniqueLength]
+	proof := header.UnsignedHeader.RandomBeacon[config.RandomBeaconUniqueLength:]
+	prevVrf := prevHeader.UnsignedHeader.RandomBeacon[:config.RandomBeaconUniqueLength]
+	if !crypto.VerifyVrf(*rawPubKey, prevVrf, vrf, proof) {
+		return fmt.Errorf("invalid header RandomBeacon %x", header.UnsignedHeader.RandomBeacon)
+	}
+
 	return nil
 }
 
@@ -352,11 +415,6 @@ func HeaderCheck(b *block.Block) error {
 		return fmt.Errorf("block height %d is different from expected height %d", header.UnsignedHeader.Height, expectedHeight)
 	}
 
-	err := SignerCheck(header)
-	if err != nil {
-		return fmt.Errorf("signer check failed: %v", err)
-	}
-
 	currentHash := DefaultLedger.Store.GetCurrentBlockHash()
 	prevHash, err := Uint256ParseFromBytes(header.UnsignedHeader.PrevBlockHash)
 	if err != nil {
@@ -374,29 +432,15 @@ func HeaderCheck(b *block.Block) error {
 		return fmt.Errorf("cannot get prev header by hash %x", currentHash.ToArray())
 	}
 
-	if prevHeader.UnsignedHeader.Timestamp >= header.UnsignedHeader.Timestamp {
-		return fmt.Errorf("header timestamp %d is not greater than prev timestamp %d", header.UnsignedHeader.Timestamp, prevHeader.UnsignedHeader.Timestamp)
+	err = signerCheck(header, prevHeader, expectedHeight-1)
+	if err != nil {
+		return fmt.Errorf("signer check failed: %v", err)
 	}
 
 	if header.UnsignedHeader.WinnerType == pb.GENESIS_SIGNER && header.UnsignedHeader.Height >= NumGenesisBlocks {
 		return fmt.Errorf("invalid winner type %v for height %d", pb.GENESIS_SIGNER, header.UnsignedHeader.Height)
 	}
 
-	if len(header.UnsignedHeader.RandomBeacon) != config.RandomBeaconLength {
-		return fmt.Errorf("invalid header RandomBeacon length %d, expecting %d", len(header.UnsignedHeader.RandomBeacon), config.RandomBeaconLength)
-	}
-
-	rawPubKey, err := crypto.DecodePoint(header.UnsignedHeader.SignerPk)
-	if err != nil {
-		return err
-	}
-	vrf := header.UnsignedHeader.RandomBeacon[:config.RandomBeaconUniqueLength]
-	proof := header.UnsignedHeader.RandomBeacon[config.RandomBeaconUniqueLength:]
-	prevVrf := prevHeader.UnsignedHeader.RandomBeacon[:config.RandomBeaconUniqueLength]
-	if !crypto.VerifyVrf(*rawPubKey, prevVrf, vrf, proof) {
-		return fmt.Errorf("invalid header RandomBeacon %x", header.UnsignedHeader.RandomBeacon)
-	}
-
 	b.IsHeaderChecked = true
 
 	return nil
@@ -0,0 +1,145 @@
+package chain
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/nknorg/nkn/block"
+	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/transaction"
+)
+
+// testSignerStore is a ledger store of blocks where the block hash at each
+// height is the height itself.
+type testSignerStore struct {
+	ILedgerStore
+	blocks []*block.Block
+}
+
+func (s *testSignerStore) GetHeight() uint32 {
+	return uint32(len(s.blocks) - 1)
+}
+
+func (s *testSignerStore) GetHeaderHashByHeight(height uint32) Uint256 {
+	return Uint256{byte(height)}
+}
+
+func (s *testSignerStore) GetBlockHash(height uint32) (Uint256, error) {
+	return Uint256{byte(height)}, nil
+}
+
+func (s *testSignerStore) GetHeader(hash Uint256) (*block.Header, error) {
+	return s.blocks[hash[0]].Header, nil
+}
+
+func (s *testSignerStore) GetBlock(hash Uint256) (*block.Block, error) {
+	return s.blocks[hash[0]], nil
+}
+
+func newTestSignerStore(numBlocks int, blockTime int64) *testSignerStore {
+	s := &testSignerStore{}
+	for i := 0; i < numBlocks; i++ {
+		winnerType := pb.BLOCK_SIGNER
+		if i == 0 {
+			winnerType = pb.GENESIS_SIGNER
+		}
+		s.blocks = append(s.blocks, &block.Block{
+			Header: &block.Header{
+				Header: &pb.Header{
+					UnsignedHeader: &pb.UnsignedHeader{
+						Height:     uint32(i),
+						Timestamp:  int64(i) * blockTime,
+						WinnerType: winnerType,
+						SignerPk:   []byte{byte(i)},
+						SignerId:   []byte{byte(i)},
+					},
+				},
+			},
+		})
+	}
+	return s
+}
+
+func TestGetNextBlockSigner(t *testing.T) {
+	store := newTestSignerStore(11, 20)
+	defer func(ledger *Ledger) { DefaultLedger = ledger }(DefaultLedger)
+	DefaultLedger = &Ledger{Store: store}
+
+	// Live callers always pass current height 10, so signers counted back
+	// from height are the same as counted back from current ledger height.
+	// signer is the height of the block whose signer is expected, or -1 if no
+	// signer is expected.
+	tests := []struct {
+		name      string
+		height    uint32
+		timestamp int64
+		signer    int
+	}{
+		{"current height in consensus duration", 10, 220, -1},
+		{"current height after proposer change", 10, 260, 9},
+		{"current height within proposing tolerance", 10, 270, 9},
+		{"current height out of proposing tolerance", 10, 275, -1},
+		{"current height after two proposer changes", 10, 320, 8},
+		{"current height before genesis", 10, 920, 0},
+		{"previous height after proposer change", 5, 160, 4},
+		{"previous height after two proposer changes", 5, 220, 3},
+		{"genesis height", 0, 20, 0},
+	}
+
+	for _, test := range tests {
+		publicKey, chordID, _, err := GetNextBlockSigner(test.height, test.timestamp)
+		if err != nil {
+			t.Errorf("%s: get next block signer error: %v", test.name, err)
+			continue
+		}
+		var expected []byte
+		if test.signer >= 0 {
+			expected = []byte{byte(test.signer)}
+		}
+		if !bytes.Equal(publicKey, expected) || !bytes.Equal(chordID, expected) {
+			t.Errorf("%s: expect signer %x, got public key %x and chord ID %x", test.name, expected, publicKey, chordID)
+		}
+	}
+
+	if _, _, _, err := GetNextBlockSigner(11, 300); err == nil {
+		t.Errorf("height above current height: expect error, got nil")
+	}
+}
+
+func TestTransactionsRootCheck(t *testing.T) {
+	txn1 := newTestTxn(t, 1, 0, 0)
+	txn2 := newTestTxn(t, 2, 0, 0)
+	forged := newTestTxn(t, 3, 0, 0)
+
+	b := &block.Block{
+		Header: &block.Header{
+			Header: &pb.Header{
+				UnsignedHeader: &pb.UnsignedHeader{},
+			},
+		},
+		Transactions: []*transaction.Transaction{txn1, txn2},
+	}
+	if err := b.RebuildMerkleRoot(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		txns  []*transaction.Transaction
+		valid bool
+	}{
+		{"original transactions", []*transaction.Transaction{txn1, txn2}, true},
+		{"forged transaction", []*transaction.Transaction{txn1, forged}, false},
+		{"extra transaction", []*transaction.Transaction{txn1, txn2, forged}, false},
+		{"missing transaction", []*transaction.Transaction{txn1}, false},
+		{"reordered transactions", []*transaction.Transaction{txn2, txn1}, false},
+	}
+
+	for _, test := range tests {
+		b.Transactions = test.txns
+		if err := TransactionsRootCheck(b); (err == nil) != test.valid {
+			t.Errorf("%s: expect valid %v, got error %v", test.name, test.valid, err)
+		}
+	}
+}
@@ -64,6 +64,22 @@ func (bc *Blockchain) AddBlock(block *block.Block, fastAdd bool) error {
 	return nil
 }
 
+// SaveStateSnapshot saves blocks ending at a pivot block whose states have been
+// synced from neighbors, and continues the chain from the pivot block.
+func (bc *Blockchain) SaveStateSnapshot(blocks []*block.Block, donationStateRoot Uint256) error {
+	bc.mutex.Lock()
+	defer bc.mutex.Unlock()
+
+	err := DefaultLedger.Store.SaveStateSnapshot(blocks, donationStateRoot)
+	if err != nil {
+		return err
+	}
+
+	bc.BlockHeight = blocks[len(blocks)-1].Header.UnsignedHeader.Height
+
+	return nil
+}
+
 func (bc *Blockchain) GetHeader(hash Uint256) (*block.Header, error) {
 	header, err := DefaultLedger.Store.GetHeader(hash)
 	if err != nil {
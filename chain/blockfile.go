@@ -0,0 +1,157 @@
+package chain
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/nknorg/nkn/block"
+	"github.com/nknorg/nkn/util/config"
+)
+
+// blockFileMagic is written at the beginning of a block file, followed by the
+// start and end height of blocks in the file. Each block is then written as a
+// 4 bytes length followed by the marshaled block.
+const blockFileMagic = "NKNBLOCK"
+
+// readBlockFileHeader reads the header of a block file and returns the start
+// and end height of blocks in the file.
+func readBlockFileHeader(r io.Reader) (uint32, uint32, error) {
+	buf := make([]byte, len(blockFileMagic)+8)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return 0, 0, fmt.Errorf("read block file header error: %v", err)
+	}
+
+	if !bytes.Equal(buf[:len(blockFileMagic)], []byte(blockFileMagic)) {
+		return 0, 0, errors.New("invalid block file magic")
+	}
+
+	startHeight := binary.LittleEndian.Uint32(buf[len(blockFileMagic):])
+	endHeight := binary.LittleEndian.Uint32(buf[len(blockFileMagic)+4:])
+	if startHeight > endHeight {
+		return 0, 0, fmt.Errorf("invalid block file height range [%d, %d]", startHeight, endHeight)
+	}
+
+	return startHeight, endHeight, nil
+}
+
+// ExportBlocks writes blocks from startHeight to endHeight (inclusive) in local
+// ledger to w. progress, if not nil, is called after each block is written.
+func ExportBlocks(w io.Writer, startHeight, endHeight uint32, progress func(height uint32)) error {
+	if startHeight > endHeight {
+		return fmt.Errorf("start height %d is higher than end height %d", startHeight, endHeight)
+	}
+
+	if endHeight > DefaultLedger.Store.GetHeight() {
+		return fmt.Errorf("end height %d is higher than current height %d", endHeight, DefaultLedger.Store.GetHeight())
+	}
+
+	buf := make([]byte, len(blockFileMagic)+8)
+	copy(buf, blockFileMagic)
+	binary.LittleEndian.PutUint32(buf[len(blockFileMagic):], startHeight)
+	binary.LittleEndian.PutUint32(buf[len(blockFileMagic)+4:], endHeight)
+	if _, err := w.Write(buf); err != nil {
+		return err
+	}
+
+	lenBuf := make([]byte, 4)
+	for height := startHeight; height <= endHeight; height++ {
+		b, err := DefaultLedger.Store.GetBlockByHeight(height)
+		if err != nil {
+			return fmt.Errorf("get block at height %d error: %v", height, err)
+		}
+
+		data, err := b.Marshal()
+		if err != nil {
+			return err
+		}
+
+		binary.LittleEndian.PutUint32(lenBuf, uint32(len(data)))
+		if _, err = w.Write(lenBuf); err != nil {
+			return err
+		}
+		if _, err = w.Write(data); err != nil {
+			return err
+		}
+
+		if progress != nil {
+			progress(height)
+		}
+
+		if height == endHeight {
+			break
+		}
+	}
+
+	return nil
+}
+
+// ImportBlocks reads blocks written by ExportBlocks from r and adds them to
+// local ledger with full header and transaction validation. Blocks that are
+// not higher than the current height are checked against local block hash and
+// skipped, so an interrupted import can be resumed by importing the same file
+// again. progress, if not nil, is called after each block is read, together
+// with the end height of the file and whether the block is imported. Returns
+// the number of imported blocks.
+func ImportBlocks(r io.Reader, progress func(height, endHeight uint32, imported bool)) (uint32, error) {
+	startHeight, endHeight, err := readBlockFileHeader(r)
+	if err != nil {
+		return 0, err
+	}
+
+	var numImported uint32
+	lenBuf := make([]byte, 4)
+	for height := startHeight; height <= endHeight; height++ {
+		if _, err = io.ReadFull(r, lenBuf); err != nil {
+			return numImported, fmt.Errorf("read block at height %d error: %v", height, err)
+		}
+
+		length := binary.LittleEndian.Uint32(lenBuf)
+		if length > 2*config.MaxBlockSize {
+			return numImported, fmt.Errorf("block at height %d has invalid size %d", height, length)
+		}
+
+		data := make([]byte, length)
+		if _, err = io.ReadFull(r, data); err != nil {
+			return numImported, fmt.Errorf("read block at height %d error: %v", height, err)
+		}
+
+		b := &block.Block{}
+		if err = b.Unmarshal(data); err != nil {
+			return numImported, fmt.Errorf("unmarshal block at height %d error: %v", height, err)
+		}
+
+		if b.Header.UnsignedHeader.Height != height {
+			return numImported, fmt.Errorf("block height %d is different from expected height %d", b.Header.UnsignedHeader.Height, height)
+		}
+
+		imported := false
+		if height <= DefaultLedger.Store.GetHeight() {
+			localHash, err := DefaultLedger.Store.GetBlockHash(height)
+			if err != nil {
+				return numImported, fmt.Errorf("get local block hash at height %d error: %v", height, err)
+			}
+			if localHash != b.Hash() {
+				return numImported, fmt.Errorf("block hash at height %d is different from local block hash %s", height, localHash.ToHexString())
+			}
+		} else {
+			if err = DefaultLedger.Blockchain.AddBlock(b, false); err != nil {
+				return numImported, fmt.Errorf("add block at height %d error: %v", height, err)
+			}
+			numImported++
+			imported = true
+		}
+
+		if progress != nil {
+			progress(height, endHeight, imported)
+		}
+
+		if height == endHeight {
+			break
+		}
+	}
+
+	return numImported, nil
+}
@@ -0,0 +1,101 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	. "github.com/nknorg/nkn/common"
+)
+
+const (
+	VerifyCheckBlock            = "block"
+	VerifyCheckLinkage          = "linkage"
+	VerifyCheckTransactionsRoot = "transactionsRoot"
+	VerifyCheckSigner           = "signer"
+	VerifyCheckStateRoot        = "stateRoot"
+)
+
+// BlockVerifyError is the first failed check of a block saved in ledger.
+type BlockVerifyError struct {
+	Height uint32 `json:"height"`
+	Hash   string `json:"hash,omitempty"`
+	Check  string `json:"check"`
+	Reason string `json:"error"`
+}
+
+func (e *BlockVerifyError) Error() string {
+	return fmt.Sprintf("block %d %s check failed: %s", e.Height, e.Check, e.Reason)
+}
+
+func newBlockVerifyError(height uint32, hash Uint256, check string, err error) *BlockVerifyError {
+	e := &BlockVerifyError{
+		Height: height,
+		Check:  check,
+		Reason: err.Error(),
+	}
+	if hash != EmptyUint256 {
+		e.Hash = hash.ToHexString()
+	}
+	return e
+}
+
+// VerifyStoredBlock verifies the block saved in ledger at a given height
+// against its previous block, including block hash, header linkage,
+// transactions root, signer and signature, and optionally state root, which
+// requires the states of previous block not being pruned. Returns nil if all
+// checks pass.
+func VerifyStoredBlock(ctx context.Context, height uint32, checkStateRoot bool) *BlockVerifyError {
+	blockHash, err := DefaultLedger.Store.GetBlockHash(height)
+	if err != nil {
+		return newBlockVerifyError(height, EmptyUint256, VerifyCheckBlock, fmt.Errorf("get block hash error: %v", err))
+	}
+
+	b, err := DefaultLedger.Store.GetBlock(blockHash)
+	if err != nil {
+		return newBlockVerifyError(height, blockHash, VerifyCheckBlock, fmt.Errorf("get block error: %v", err))
+	}
+
+	if b.Header.UnsignedHeader.Height != height {
+		return newBlockVerifyError(height, blockHash, VerifyCheckBlock, fmt.Errorf("block height %d is different from expected height", b.Header.UnsignedHeader.Height))
+	}
+
+	if computedHash := b.Hash(); computedHash != blockHash {
+		return newBlockVerifyError(height, blockHash, VerifyCheckBlock, fmt.Errorf("computed block hash %s is different from indexed hash", computedHash.ToHexString()))
+	}
+
+	if err = TransactionsRootCheck(b); err != nil {
+		return newBlockVerifyError(height, blockHash, VerifyCheckTransactionsRoot, err)
+	}
+
+	if height == 0 {
+		return nil
+	}
+
+	prevHeader, err := DefaultLedger.Store.GetHeaderByHeight(height - 1)
+	if err != nil {
+		return newBlockVerifyError(height, blockHash, VerifyCheckLinkage, fmt.Errorf("get prev header error: %v", err))
+	}
+
+	prevHash := prevHeader.Hash()
+	if !bytes.Equal(b.Header.UnsignedHeader.PrevBlockHash, prevHash.ToArray()) {
+		return newBlockVerifyError(height, blockHash, VerifyCheckLinkage, fmt.Errorf("prev block hash %x is different from hash of block %d %s", b.Header.UnsignedHeader.PrevBlockHash, height-1, prevHash.ToHexString()))
+	}
+
+	if err = verifyHeaderSigner(b.Header, prevHeader, height-1); err != nil {
+		return newBlockVerifyError(height, blockHash, VerifyCheckSigner, err)
+	}
+
+	if checkStateRoot {
+		root, err := DefaultLedger.Store.GenerateStateRootFromParent(ctx, b)
+		if err != nil {
+			return newBlockVerifyError(height, blockHash, VerifyCheckStateRoot, fmt.Errorf("generate state root error: %v", err))
+		}
+
+		if !bytes.Equal(root.ToArray(), b.Header.UnsignedHeader.StateRoot) {
+			return newBlockVerifyError(height, blockHash, VerifyCheckStateRoot, fmt.Errorf("computed state root %s is different from state root in header %x", root.ToHexString(), b.Header.UnsignedHeader.StateRoot))
+		}
+	}
+
+	return nil
+}
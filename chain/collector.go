@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"math/big"
 	"sort"
 
 	. "github.com/nknorg/nkn/common"
@@ -57,16 +58,37 @@ func (tc *TxnCollector) Cleanup(txns []*transaction.Transaction) error {
 	return tc.TxnSource.CleanSubmittedTransactions(txns)
 }
 
+// GetTxnFeeRate returns the fee per byte of a transaction
+func GetTxnFeeRate(txn *transaction.Transaction) float64 {
+	return float64(txn.UnsignedTx.Fee) / float64(txn.GetSize())
+}
+
+// CompareTxnFeeRate compares the priority of two transactions by fee per byte,
+// and prefers the smaller one if fee per byte is the same. Returns 1 if txn1
+// has higher priority, -1 if txn2 has higher priority, and 0 otherwise.
+func CompareTxnFeeRate(txn1, txn2 *transaction.Transaction) int {
+	// fee1 / size1 vs fee2 / size2 without losing precision
+	rate1 := new(big.Int).Mul(big.NewInt(txn1.UnsignedTx.Fee), big.NewInt(int64(txn2.GetSize())))
+	rate2 := new(big.Int).Mul(big.NewInt(txn2.UnsignedTx.Fee), big.NewInt(int64(txn1.GetSize())))
+	if c := rate1.Cmp(rate2); c != 0 {
+		return c
+	}
+	if txn1.GetSize() < txn2.GetSize() {
+		return 1
+	}
+	if txn1.GetSize() > txn2.GetSize() {
+		return -1
+	}
+	return 0
+}
+
 type sortTxnsByPriceSize []*transaction.Transaction
 
 func (s sortTxnsByPriceSize) Len() int      { return len(s) }
 func (s sortTxnsByPriceSize) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
 
 func (s sortTxnsByPriceSize) Less(i, j int) bool {
-	if s[i].UnsignedTx.Fee == s[j].UnsignedTx.Fee {
-		return s[i].GetSize() > s[j].GetSize()
-	}
-	return s[i].UnsignedTx.Fee < s[j].UnsignedTx.Fee
+	return CompareTxnFeeRate(s[i], s[j]) < 0
 }
 
 type TxnCollection struct {
@@ -14,12 +14,17 @@ const (
 	DATA_Header      DataEntryPrefix = 0x01
 	DATA_Transaction DataEntryPrefix = 0x02
 
+	// INDEX
+	IX_AddressTransaction       DataEntryPrefix = 0x10
+	IX_AddressTransactionHeight DataEntryPrefix = 0x11
+
 	ST_Prepaid   DataEntryPrefix = 0xc7
 	ST_StateTrie DataEntryPrefix = 0xc8
 
 	//SYSTEM
-	SYS_CurrentBlock DataEntryPrefix = 0x40
-	SYS_Donations    DataEntryPrefix = 0x42
+	SYS_CurrentBlock       DataEntryPrefix = 0x40
+	SYS_Donations          DataEntryPrefix = 0x42
+	SYS_HistoryStartHeight DataEntryPrefix = 0x43
 
 	//CONFIG
 	CFG_Version DataEntryPrefix = 0xf0
@@ -29,6 +34,10 @@ const (
 	TRIE_RefCount       DataEntryPrefix = 0xa1
 	TRIE_RefCountHeight DataEntryPrefix = 0xa2
 	TRIE_PrunedHeight   DataEntryPrefix = 0xa3
+	TRIE_SyncRoot       DataEntryPrefix = 0xa4
+
+	//MESSAGE BUFFER
+	MSGBUF_Message DataEntryPrefix = 0xb0
 )
 
 func paddingKey(prefix DataEntryPrefix, key []byte) []byte {
@@ -49,6 +58,12 @@ func DonationKey(height uint32) []byte {
 	return paddingKey(SYS_Donations, heightBuffer)
 }
 
+// HistoryStartHeightKey stores the height from which blocks are available in
+// local ledger, which is set when ledger is synced from a state snapshot.
+func HistoryStartHeightKey() []byte {
+	return paddingKey(SYS_HistoryStartHeight, nil)
+}
+
 func CurrentStateTrie() []byte {
 	return paddingKey(ST_StateTrie, nil)
 }
@@ -72,6 +87,26 @@ func TransactionKey(txHash common.Uint256) []byte {
 	return paddingKey(DATA_Transaction, txHash.ToArray())
 }
 
+func AddressTransactionPrefix(programHash common.Uint160) []byte {
+	return paddingKey(IX_AddressTransaction, programHash.ToArray())
+}
+
+// AddressTransactionKey is ordered by height so that iterating over an address
+// prefix returns transactions in block order.
+func AddressTransactionKey(programHash common.Uint160, height uint32, txHash common.Uint256) []byte {
+	heightBuffer := make([]byte, 4)
+	binary.BigEndian.PutUint32(heightBuffer[:], height)
+
+	key := append(AddressTransactionPrefix(programHash), heightBuffer...)
+	return append(key, txHash.ToArray()...)
+}
+
+// AddressTransactionHeightKey stores the height from which address transaction
+// index is complete.
+func AddressTransactionHeightKey() []byte {
+	return paddingKey(IX_AddressTransactionHeight, nil)
+}
+
 func TrieNodeKey(key []byte) []byte {
 	return paddingKey(TRIE_Node, key)
 }
@@ -87,3 +122,24 @@ func TrieRefCountHeightKey() []byte {
 func TriePrunedHeightKey() []byte {
 	return paddingKey(TRIE_PrunedHeight, nil)
 }
+
+// TrieSyncRootKey stores the state root being synced from neighbors, and is
+// removed when synced states are saved.
+func TrieSyncRootKey() []byte {
+	return paddingKey(TRIE_SyncRoot, nil)
+}
+
+func TrieNodePrefix() []byte {
+	return paddingKey(TRIE_Node, nil)
+}
+
+func MessageBufferPrefix() []byte {
+	return paddingKey(MSGBUF_Message, nil)
+}
+
+func MessageBufferKey(clientID []byte, seq uint64) []byte {
+	key := make([]byte, len(clientID)+8)
+	copy(key, clientID)
+	binary.BigEndian.PutUint64(key[len(clientID):], seq)
+	return paddingKey(MSGBUF_Message, key)
+}
@@ -2,6 +2,7 @@ package chain
 
 import (
 	"errors"
+	"strconv"
 
 	"github.com/nknorg/nkn/block"
 	. "github.com/nknorg/nkn/common"
@@ -41,7 +42,7 @@ func GetDefaultLedger() (*Ledger, error) {
 func (l *Ledger) GetBlockWithHeight(height uint32) (*block.Block, error) {
 	temp, err := l.Store.GetBlockHash(height)
 	if err != nil {
-		return nil, errors.New("[Ledger],GetBlockWithHeight failed with height=" + string(height))
+		return nil, errors.New("[Ledger],GetBlockWithHeight failed with height=" + strconv.Itoa(int(height)))
 	}
 	bk, err := DefaultLedger.Store.GetBlock(temp)
 	if err != nil {
@@ -8,17 +8,8 @@ import (
 	"github.com/nknorg/nkn/transaction"
 )
 
-// ILedgerStore provides func with store package.
-type ILedgerStore interface {
-	SaveBlock(b *block.Block, fastAdd bool) error
-	GetBlock(hash Uint256) (*block.Block, error)
-	GetBlockByHeight(height uint32) (*block.Block, error)
-	GetBlockHash(height uint32) (Uint256, error)
-	IsDoubleSpend(tx *transaction.Transaction) bool
-	AddHeader(header *block.Header) error
-	GetHeader(hash Uint256) (*block.Header, error)
-	GetHeaderByHeight(height uint32) (*block.Header, error)
-	GetTransaction(hash Uint256) (*transaction.Transaction, error)
+// IStateReader provides read-only access to states.
+type IStateReader interface {
 	GetName(registrant []byte) (string, error)
 	GetRegistrant(name string) ([]byte, error)
 	IsSubscribed(topic string, bucket uint32, subscriber []byte, identifier string) (bool, error)
@@ -31,6 +22,25 @@ type ILedgerStore interface {
 	GetBalanceByAssetID(addr Uint160, assetID Uint256) Fixed64
 	GetNonce(addr Uint160) uint64
 	GetNanoPay(addr Uint160, recipient Uint160, nonce uint64) (Fixed64, uint32, error)
+	GetAsset(assetID Uint256) (name, symbol string, totalSupply Fixed64, precision uint32, err error)
+}
+
+// ILedgerStore provides func with store package.
+type ILedgerStore interface {
+	SaveBlock(b *block.Block, fastAdd bool) error
+	GetBlock(hash Uint256) (*block.Block, error)
+	GetBlockByHeight(height uint32) (*block.Block, error)
+	GetBlockHash(height uint32) (Uint256, error)
+	IsDoubleSpend(tx *transaction.Transaction) bool
+	AddHeader(header *block.Header) error
+	GetHeader(hash Uint256) (*block.Header, error)
+	GetHeaderByHeight(height uint32) (*block.Header, error)
+	GetTransaction(hash Uint256) (*transaction.Transaction, error)
+	GetTransactionHeight(hash Uint256) (uint32, error)
+	GetTransactionProof(hash Uint256) (*block.Header, uint32, []Uint256, error)
+	GetTransactionsByAddr(addr Uint160, offset, limit uint32) ([]Uint256, error)
+	IStateReader
+	GetStateReaderAtHeight(height uint32) (IStateReader, error)
 	GetCurrentBlockHash() Uint256
 	GetCurrentHeaderHash() Uint256
 	GetHeaderHeight() uint32
@@ -44,7 +54,14 @@ type ILedgerStore interface {
 	IsBlockInStore(hash Uint256) bool
 	Rollback(b *block.Block) error
 	GenerateStateRoot(ctx context.Context, b *block.Block, genesisBlockInitialized, needBeCommitted bool) (Uint256, error)
-	GetAsset(assetID Uint256) (name, symbol string, totalSupply Fixed64, precision uint32, err error)
+	GenerateStateRootFromParent(ctx context.Context, b *block.Block) (Uint256, error)
+	GetStateProof(key []byte, height uint32) (Uint256, []byte, [][]byte, error)
+	GetStateNode(hash []byte) ([]byte, error)
+	HasStateNode(hash []byte) bool
+	PutStateNode(hash, node []byte) error
+	StartStateSync(root Uint256) error
+	SaveStateSnapshot(blocks []*block.Block, donationStateRoot Uint256) error
+	GetHistoryStartHeight() uint32
 
 	Close()
 }
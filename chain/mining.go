@@ -53,6 +53,63 @@ func isLowFeeTxnFull(txnCount uint32, txnSize uint32) bool {
 	return false
 }
 
+// collectTxns pops transactions from txnCollection in priority order and
+// returns the ones that pass verifyTxn and fit into a block that already has
+// txnCount transactions of txnSize bytes. Low fee transactions that no longer
+// fit into the low fee quota are skipped, as transactions ranked after them
+// may still pay the full fee.
+func collectTxns(ctx context.Context, txnCollection *TxnCollection, bvs *BlockValidationState, txnCount, txnSize uint32, verifyTxn func(*transaction.Transaction) error) ([]*transaction.Transaction, error) {
+	var txnList []*transaction.Transaction
+	var lowFeeTxCount, lowFeeTxSize uint32
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		txn := txnCollection.Peek()
+		if txn == nil {
+			break
+		}
+
+		if isBlockFull(txnCount+1, txnSize+txn.GetSize()) {
+			break
+		}
+
+		isLowFee := txn.UnsignedTx.Fee < config.Parameters.MinTxnFee
+		if isLowFee && isLowFeeTxnFull(lowFeeTxCount+1, lowFeeTxSize+txn.GetSize()) {
+			txnCollection.Pop()
+			continue
+		}
+
+		if err := verifyTxn(txn); err != nil {
+			log.Warningf("invalid transaction: %v", err)
+			bvs.Reset()
+			txnCollection.Pop()
+			continue
+		}
+
+		txnList = append(txnList, txn)
+		if err := txnCollection.Update(); err != nil {
+			bvs.Reset()
+			txnCollection.Pop()
+			continue
+		}
+
+		bvs.Commit()
+		txnCount++
+		txnSize += txn.GetSize()
+		if isLowFee {
+			lowFeeTxCount++
+			lowFeeTxSize += txn.GetSize()
+		}
+	}
+
+	return txnList, nil
+}
+
 func (bm *BuiltinMining) BuildBlock(ctx context.Context, height uint32, chordID []byte, winnerHash common.Uint256, winnerType pb.WinnerType) (*block.Block, error) {
 	var txnList []*transaction.Transaction
 	var txnHashList []common.Uint256
@@ -66,7 +123,6 @@ func (bm *BuiltinMining) BuildBlock(ctx context.Context, height uint32, chordID
 	txnHashList = append(txnHashList, coinbase.Hash())
 	totalTxSize := coinbase.GetSize()
 	totalTxCount := uint32(1)
-	var lowFeeTxCount, lowFeeTxSize uint32
 
 	if winnerType == pb.TXN_SIGNER {
 		if _, err = DefaultLedger.Store.GetTransaction(winnerHash); err != nil {
@@ -88,64 +144,25 @@ func (bm *BuiltinMining) BuildBlock(ctx context.Context, height uint32, chordID
 	}
 
 	bvs := NewBlockValidationState()
-
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-		}
-
-		txn := txnCollection.Peek()
-		if txn == nil {
-			break
-		}
-
-		if isBlockFull(totalTxCount+1, totalTxSize+txn.GetSize()) {
-			break
-		}
-
-		if txn.UnsignedTx.Fee < int64(config.Parameters.MinTxnFee) && isLowFeeTxnFull(lowFeeTxCount+1, lowFeeTxSize+txn.GetSize()) {
-			log.Info("Low fee transaction full in block")
-			break
-		}
-
+	txns, err := collectTxns(ctx, txnCollection, bvs, totalTxCount, totalTxSize, func(txn *transaction.Transaction) error {
 		if err := VerifyTransaction(txn, height); err != nil {
-			log.Warningf("invalid transaction: %v", err)
-			txnCollection.Pop()
-			continue
+			return err
 		}
 		if err := VerifyTransactionWithLedger(txn); err != nil {
-			log.Warningf("invalid transaction: %v", err)
-			txnCollection.Pop()
-			continue
-		}
-		if err := bvs.VerifyTransactionWithBlock(txn, height); err != nil {
-			log.Warningf("invalid transaction: %v", err)
-			bvs.Reset()
-			txnCollection.Pop()
-			continue
+			return err
 		}
+		return bvs.VerifyTransactionWithBlock(txn, height)
+	})
+	bvs.Close()
+	if err != nil {
+		return nil, err
+	}
 
+	for _, txn := range txns {
 		txnList = append(txnList, txn)
 		txnHashList = append(txnHashList, txn.Hash())
-		if err = txnCollection.Update(); err != nil {
-			bvs.Reset()
-			txnCollection.Pop()
-			continue
-		}
-
-		bvs.Commit()
-		totalTxCount++
-		totalTxSize += txn.GetSize()
-		if txn.UnsignedTx.Fee < int64(config.Parameters.MinTxnFee) {
-			lowFeeTxCount++
-			lowFeeTxSize += txn.GetSize()
-		}
 	}
 
-	bvs.Close()
-
 	txnRoot, err := crypto.ComputeRoot(txnHashList)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,53 @@
+package chain
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/config"
+)
+
+func newTestTxn(t *testing.T, sender byte, fee int64, attrsSize int) *transaction.Transaction {
+	payload, err := transaction.Pack(pb.TRANSFER_ASSET_TYPE, transaction.NewTransferAsset(Uint160{sender}, Uint160{}, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &transaction.Transaction{Transaction: transaction.NewMsgTx(payload, 0, Fixed64(fee), make([]byte, attrsSize))}
+}
+
+func TestCollectTxns(t *testing.T) {
+	defer func(params config.Configuration) { config.Parameters = &params }(*config.Parameters)
+	config.Parameters.MinTxnFee = 100
+	config.Parameters.NumTxnPerBlock = 0
+	config.Parameters.NumLowFeeTxnPerBlock = 1
+	config.Parameters.LowFeeTxnSizePerBlock = 0
+
+	lowFee1 := newTestTxn(t, 1, 99, 0)
+	lowFee2 := newTestTxn(t, 2, 98, 0)
+	fullFee := newTestTxn(t, 3, 100, 1024)
+
+	txnCollection := NewTxnCollection(map[Uint160][]*transaction.Transaction{
+		{1}: {lowFee1},
+		{2}: {lowFee2},
+		{3}: {fullFee},
+	})
+	if txnCollection.tops[0] != lowFee1 || txnCollection.tops[1] != lowFee2 || txnCollection.tops[2] != fullFee {
+		t.Fatal("expect small low fee transactions to rank ahead of large full fee transaction")
+	}
+
+	bvs := NewBlockValidationState()
+	defer bvs.Close()
+
+	txns, err := collectTxns(context.Background(), txnCollection, bvs, 0, 0, func(txn *transaction.Transaction) error {
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(txns) != 2 || txns[0] != lowFee1 || txns[1] != fullFee {
+		t.Fatalf("expect first low fee transaction and full fee transaction to be collected, got %d transactions", len(txns))
+	}
+}
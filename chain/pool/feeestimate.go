@@ -0,0 +1,242 @@
+package pool
+
+import (
+	"math"
+	"sort"
+
+	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/config"
+)
+
+const (
+	// DefaultFeeEstimateBlocks is the default number of recent blocks used to
+	// estimate fee
+	DefaultFeeEstimateBlocks = 20
+	// MaxFeeEstimateBlocks is the max number of recent blocks used to estimate
+	// fee
+	MaxFeeEstimateBlocks = 256
+	// defaultEstimateTxnSize is the txn size used to compute suggested fee if
+	// no txn is found in recent blocks and txpool
+	defaultEstimateTxnSize = 200
+	// historyFeeRatePercentile is the percentile of min fee rate of recent
+	// block windows that a suggested fee rate should reach
+	historyFeeRatePercentile = 0.8
+)
+
+// FeeEstimateTargets are the numbers of blocks that a txn is expected to be
+// included in
+var FeeEstimateTargets = []int{1, 2, 5, 10}
+
+// FeeEstimate is the suggested fee to be included in Blocks blocks
+type FeeEstimate struct {
+	Blocks     int     `json:"blocks"`
+	FeePerByte float64 `json:"feePerByte"`
+	Fee        int64   `json:"fee"`
+}
+
+// LowFeeLaneEstimate is the estimated number of blocks for a txn with fee
+// lower than MinTxnFee to be included. It's nil if low fee txns are not limited
+// or txn is too large for low fee lane.
+type LowFeeLaneEstimate struct {
+	NumTxnPerBlock  uint32 `json:"numTxnPerBlock"`
+	TxnSizePerBlock uint32 `json:"txnSizePerBlock"`
+	NumPendingTxns  int    `json:"numPendingTxns"`
+	Blocks          int    `json:"blocks"`
+}
+
+// FeeEstimateResult is the result of fee estimation
+type FeeEstimateResult struct {
+	TxnSize        uint32              `json:"txnSize"`
+	MinTxnFee      int64               `json:"minTxnFee"`
+	MinFeeRate     float64             `json:"minFeeRate"`
+	NumPendingTxns int                 `json:"numPendingTxns"`
+	NumBlocks      int                 `json:"numBlocks"`
+	Estimates      []*FeeEstimate      `json:"estimates"`
+	LowFeeLane     *LowFeeLaneEstimate `json:"lowFeeLane"`
+}
+
+func isFeeEstimateTxn(txn *transaction.Transaction) bool {
+	switch txn.UnsignedTx.Payload.Type {
+	case pb.COINBASE_TYPE, pb.SIG_CHAIN_TXN_TYPE:
+		return false
+	}
+	return true
+}
+
+// getRecentMinFeeRates returns the min fee per byte of normal fee txns in each
+// of the recent numBlocks blocks, oldest first, and sizes of txns in them. Min
+// fee rate of a block that is not full is 0 since any txn could be included.
+func getRecentMinFeeRates(numBlocks int) ([]float64, []uint32, error) {
+	height := chain.DefaultLedger.Store.GetHeight()
+	if uint32(numBlocks) > height {
+		numBlocks = int(height)
+	}
+
+	minFeeRates := make([]float64, 0, numBlocks)
+	txnSizes := make([]uint32, 0)
+	for h := height - uint32(numBlocks) + 1; h <= height && h > 0; h++ {
+		b, err := chain.DefaultLedger.Store.GetBlockByHeight(h)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var totalTxnSize uint32
+		minFeeRate := math.Inf(1)
+		for _, txn := range b.Transactions {
+			totalTxnSize += txn.GetSize()
+			if !isFeeEstimateTxn(txn) {
+				continue
+			}
+			txnSizes = append(txnSizes, txn.GetSize())
+			if txn.UnsignedTx.Fee < config.Parameters.MinTxnFee {
+				continue
+			}
+			if feeRate := chain.GetTxnFeeRate(txn); feeRate < minFeeRate {
+				minFeeRate = feeRate
+			}
+		}
+
+		numTxns := uint32(len(b.Transactions))
+		isFull := (config.Parameters.NumTxnPerBlock > 0 && numTxns >= config.Parameters.NumTxnPerBlock) || totalTxnSize*10 >= config.MaxBlockSize*9
+		if !isFull || math.IsInf(minFeeRate, 1) {
+			minFeeRate = 0
+		}
+
+		minFeeRates = append(minFeeRates, minFeeRate)
+	}
+
+	return minFeeRates, txnSizes, nil
+}
+
+// getHistoryFeeRate returns the fee rate that would have been included within
+// numBlocks blocks in most windows of recent blocks.
+func getHistoryFeeRate(minFeeRates []float64, numBlocks int) float64 {
+	if len(minFeeRates) == 0 {
+		return 0
+	}
+	if numBlocks > len(minFeeRates) {
+		numBlocks = len(minFeeRates)
+	}
+
+	windowRates := make([]float64, 0, len(minFeeRates)-numBlocks+1)
+	for i := 0; i+numBlocks <= len(minFeeRates); i++ {
+		rate := minFeeRates[i]
+		for _, r := range minFeeRates[i+1 : i+numBlocks] {
+			if r < rate {
+				rate = r
+			}
+		}
+		windowRates = append(windowRates, rate)
+	}
+
+	sort.Float64s(windowRates)
+
+	return windowRates[int(float64(len(windowRates)-1)*historyFeeRatePercentile)]
+}
+
+// getBacklogFeeRate returns the fee rate that a txn needs to be ahead of
+// pending txns that fill numBlocks blocks. Pending txns should be sorted by
+// priority from high to low.
+func getBacklogFeeRate(pending []*transaction.Transaction, numBlocks int) float64 {
+	maxNumTxns := int(config.Parameters.NumTxnPerBlock) * numBlocks
+	maxSize := uint64(config.MaxBlockSize) * uint64(numBlocks)
+
+	var size uint64
+	for i, txn := range pending {
+		size += uint64(txn.GetSize())
+		if (maxNumTxns > 0 && i >= maxNumTxns) || size > maxSize {
+			return chain.GetTxnFeeRate(txn)
+		}
+	}
+
+	return 0
+}
+
+// isLowFeeLaneLimited returns whether txns with fee lower than MinTxnFee are
+// limited in each block. Otherwise MinTxnFee has no effect on block assembly.
+func isLowFeeLaneLimited() bool {
+	return config.Parameters.NumLowFeeTxnPerBlock > 0 || config.Parameters.LowFeeTxnSizePerBlock > 0
+}
+
+func medianTxnSize(txnSizes []uint32) uint32 {
+	if len(txnSizes) == 0 {
+		return defaultEstimateTxnSize
+	}
+	sort.Slice(txnSizes, func(i, j int) bool { return txnSizes[i] < txnSizes[j] })
+	return txnSizes[len(txnSizes)/2]
+}
+
+// EstimateFee estimates fee of a txn with txnSize bytes to be included in each
+// of FeeEstimateTargets blocks, using fee rates of txns included in recent
+// numBlocks blocks, txns pending in txpool and txpool min fee rate. If txnSize
+// is 0, median size of recent and pending txns is used.
+func (tp *TxnPool) EstimateFee(numBlocks int, txnSize uint32) (*FeeEstimateResult, error) {
+	minFeeRates, txnSizes, err := getRecentMinFeeRates(numBlocks)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make([]*transaction.Transaction, 0)
+	numLowFeeTxns := 0
+	for _, txn := range tp.GetAllTransactions() {
+		if !isFeeEstimateTxn(txn) {
+			continue
+		}
+		pending = append(pending, txn)
+		txnSizes = append(txnSizes, txn.GetSize())
+		if txn.UnsignedTx.Fee < config.Parameters.MinTxnFee {
+			numLowFeeTxns++
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool { return compareTxnPriority(pending[i], pending[j]) > 0 })
+
+	if txnSize == 0 {
+		txnSize = medianTxnSize(txnSizes)
+	}
+
+	minFeeRate := tp.GetMinFeeRate()
+	result := &FeeEstimateResult{
+		TxnSize:        txnSize,
+		MinTxnFee:      config.Parameters.MinTxnFee,
+		MinFeeRate:     minFeeRate,
+		NumPendingTxns: len(pending),
+		NumBlocks:      len(minFeeRates),
+		Estimates:      make([]*FeeEstimate, 0, len(FeeEstimateTargets)),
+	}
+
+	for _, target := range FeeEstimateTargets {
+		feeRate := math.Max(minFeeRate, math.Max(getHistoryFeeRate(minFeeRates, target), getBacklogFeeRate(pending, target)))
+		fee := int64(math.Ceil(feeRate * float64(txnSize)))
+		if isLowFeeLaneLimited() && fee < config.Parameters.MinTxnFee {
+			fee = config.Parameters.MinTxnFee
+		}
+		result.Estimates = append(result.Estimates, &FeeEstimate{
+			Blocks:     target,
+			FeePerByte: float64(fee) / float64(txnSize),
+			Fee:        fee,
+		})
+	}
+
+	if !isLowFeeLaneLimited() {
+		return result, nil
+	}
+
+	lowFeeTxnPerBlock := config.Parameters.NumLowFeeTxnPerBlock
+	if config.Parameters.LowFeeTxnSizePerBlock > 0 {
+		if n := config.Parameters.LowFeeTxnSizePerBlock / txnSize; lowFeeTxnPerBlock == 0 || n < lowFeeTxnPerBlock {
+			lowFeeTxnPerBlock = n
+		}
+	}
+	if lowFeeTxnPerBlock > 0 {
+		result.LowFeeLane = &LowFeeLaneEstimate{
+			NumTxnPerBlock:  config.Parameters.NumLowFeeTxnPerBlock,
+			TxnSizePerBlock: config.Parameters.LowFeeTxnSizePerBlock,
+			NumPendingTxns:  numLowFeeTxns,
+			Blocks:          numLowFeeTxns/int(lowFeeTxnPerBlock) + 1,
+		}
+	}
+
+	return result, nil
+}
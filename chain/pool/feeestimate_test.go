@@ -0,0 +1,69 @@
+package pool
+
+import (
+	"testing"
+
+	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/config"
+)
+
+func TestGetHistoryFeeRate(t *testing.T) {
+	tests := []struct {
+		name        string
+		minFeeRates []float64
+		numBlocks   int
+		feeRate     float64
+	}{
+		{"no blocks", nil, 1, 0},
+		{"single block window", []float64{1, 2, 3, 4, 5}, 1, 4},
+		{"two block window", []float64{1, 2, 3, 4, 5}, 2, 3},
+		{"window larger than history", []float64{1, 2, 3, 4, 5}, 10, 1},
+		{"mostly not full blocks", []float64{0, 0, 5, 0, 0}, 1, 0},
+		{"unordered", []float64{5, 1, 4, 2, 3}, 2, 2},
+	}
+
+	for _, test := range tests {
+		if feeRate := getHistoryFeeRate(test.minFeeRates, test.numBlocks); feeRate != test.feeRate {
+			t.Errorf("%s: expect fee rate %v, got %v", test.name, test.feeRate, feeRate)
+		}
+	}
+}
+
+func TestGetBacklogFeeRate(t *testing.T) {
+	numTxnPerBlock := config.Parameters.NumTxnPerBlock
+	defer func() { config.Parameters.NumTxnPerBlock = numTxnPerBlock }()
+
+	pending := make([]*transaction.Transaction, 0)
+	for fee := int64(5000); fee > 0; fee -= 1000 {
+		pending = append(pending, newTestTxn(fee, 0))
+	}
+
+	large := make([]*transaction.Transaction, 0)
+	for fee := int64(5000); fee > 0; fee -= 1000 {
+		large = append(large, newTestTxn(fee, config.MaxBlockSize*2/5))
+	}
+
+	tests := []struct {
+		name           string
+		numTxnPerBlock uint32
+		pending        []*transaction.Transaction
+		numBlocks      int
+		feeRate        float64
+	}{
+		{"empty txpool", 2, nil, 1, 0},
+		{"one block by count", 2, pending, 1, chain.GetTxnFeeRate(pending[2])},
+		{"two blocks by count", 2, pending, 2, chain.GetTxnFeeRate(pending[4])},
+		{"backlog fits", 2, pending, 3, 0},
+		{"one block by size", 0, large, 1, chain.GetTxnFeeRate(large[2])},
+		{"two blocks by size", 0, large, 2, chain.GetTxnFeeRate(large[4])},
+		{"size limit before count limit", 4, large, 1, chain.GetTxnFeeRate(large[2])},
+	}
+
+	for _, test := range tests {
+		config.Parameters.NumTxnPerBlock = test.numTxnPerBlock
+		if feeRate := getBacklogFeeRate(test.pending, test.numBlocks); feeRate != test.feeRate {
+			t.Errorf("%s: expect fee rate %v, got %v", test.name, test.feeRate, feeRate)
+		}
+	}
+}
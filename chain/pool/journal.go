@@ -0,0 +1,219 @@
+package pool
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/common/serialization"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/log"
+)
+
+const (
+	journalCompactInterval = 10 * time.Minute
+)
+
+// txnJournal is an append only file of txns admitted to txpool, so that
+// pending txns can be restored after restart. Each record is a var bytes of
+// marshaled txn.
+type txnJournal struct {
+	sync.Mutex
+	path     string
+	file     *os.File
+	loadOnce sync.Once
+}
+
+func newTxnJournal(path string) *txnJournal {
+	return &txnJournal{
+		path: path,
+	}
+}
+
+func (j *txnJournal) insert(txn *transaction.Transaction) error {
+	buf, err := txn.Marshal()
+	if err != nil {
+		return err
+	}
+
+	b := bytes.NewBuffer(nil)
+	err = serialization.WriteVarBytes(b, buf)
+	if err != nil {
+		return err
+	}
+
+	j.Lock()
+	defer j.Unlock()
+
+	if j.file == nil {
+		j.file, err = os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = j.file.Write(b.Bytes())
+	return err
+}
+
+// load reads all txns in journal. A broken record, e.g. partially written
+// before crash, ends the journal.
+func (j *txnJournal) load() ([]*transaction.Transaction, error) {
+	j.Lock()
+	defer j.Unlock()
+
+	f, err := os.Open(j.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	txns := make([]*transaction.Transaction, 0)
+	for {
+		buf, err := serialization.ReadVarBytes(r)
+		if err != nil {
+			if err != io.EOF {
+				log.Warningf("Read txpool journal error: %v", err)
+			}
+			break
+		}
+
+		txn := &transaction.Transaction{}
+		err = txn.Unmarshal(buf)
+		if err != nil {
+			log.Warningf("Unmarshal txn in txpool journal error: %v", err)
+			break
+		}
+
+		txns = append(txns, txn)
+	}
+
+	return txns, nil
+}
+
+// rotate replaces journal with txns. Caller should hold the lock so that no
+// txn is appended to the old journal after txns are collected.
+func (j *txnJournal) rotate(txns []*transaction.Transaction) error {
+	tmpPath := j.path + ".new"
+	f, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_TRUNC|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	for _, txn := range txns {
+		buf, err := txn.Marshal()
+		if err != nil {
+			f.Close()
+			return err
+		}
+		err = serialization.WriteVarBytes(w, buf)
+		if err != nil {
+			f.Close()
+			return err
+		}
+	}
+
+	err = w.Flush()
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+
+	if j.file != nil {
+		j.file.Close()
+		j.file = nil
+	}
+
+	return os.Rename(tmpPath, j.path)
+}
+
+// journalTxn appends txn to journal if journal is enabled
+func (tp *TxnPool) journalTxn(txn *transaction.Transaction) {
+	if tp.journal == nil || txn.UnsignedTx.Payload.Type == pb.SIG_CHAIN_TXN_TYPE {
+		return
+	}
+
+	err := tp.journal.insert(txn)
+	if err != nil {
+		log.Warningf("Write txn to txpool journal error: %v", err)
+	}
+}
+
+// compactJournal rewrites journal with txns currently in txpool
+func (tp *TxnPool) compactJournal() {
+	tp.journal.Lock()
+	defer tp.journal.Unlock()
+
+	err := tp.journal.rotate(tp.GetAllTransactions())
+	if err != nil {
+		log.Warningf("Compact txpool journal error: %v", err)
+	}
+}
+
+// LoadJournal replays txns in journal through AppendTxnPool, and returns txns
+// that are restored. Txns already on chain or no longer valid are dropped.
+// Journal is compacted after loading and then periodically. It should be
+// called after local ledger is up to date, and only loads journal once.
+func (tp *TxnPool) LoadJournal() ([]*transaction.Transaction, error) {
+	if tp.journal == nil {
+		return nil, nil
+	}
+
+	var restored []*transaction.Transaction
+	var err error
+	tp.journal.loadOnce.Do(func() {
+		var txns []*transaction.Transaction
+		txns, err = tp.journal.load()
+		if err != nil {
+			return
+		}
+
+		// Txns of the same account may be journaled out of nonce order when
+		// admitted concurrently.
+		sort.SliceStable(txns, func(i, j int) bool {
+			return txns[i].UnsignedTx.Nonce < txns[j].UnsignedTx.Nonce
+		})
+
+		restored = make([]*transaction.Transaction, 0, len(txns))
+		for _, txn := range txns {
+			hash := txn.Hash()
+			if chain.DefaultLedger.Store.IsTxHashDuplicate(hash) {
+				continue
+			}
+			if err := tp.AppendTxnPool(txn); err != nil {
+				log.Debugf("Drop txn %s in txpool journal: %v", hash.ToHexString(), err)
+				continue
+			}
+			restored = append(restored, txn)
+		}
+
+		log.Infof("Restored %d of %d txns from txpool journal", len(restored), len(txns))
+
+		tp.compactJournal()
+
+		go func() {
+			for {
+				time.Sleep(journalCompactInterval)
+				tp.compactJournal()
+			}
+		}()
+	})
+
+	return restored, err
+}
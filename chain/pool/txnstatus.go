@@ -0,0 +1,230 @@
+package pool
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/log"
+)
+
+// Lifecycle status of a txn submitted to txpool
+const (
+	TxnStatusReceived  = "received"
+	TxnStatusRejected  = "rejected"
+	TxnStatusPending   = "pending"
+	TxnStatusReplaced  = "replaced"
+	TxnStatusEvicted   = "evicted"
+	TxnStatusConfirmed = "confirmed"
+)
+
+const (
+	txnStatusCleanupInterval = time.Minute
+	maxTxnStatusHistory      = 16
+	txnStatusQueueSize       = 1024
+)
+
+// TxnStatusUpdate is a lifecycle status change of a txn
+type TxnStatusUpdate struct {
+	TxnHash    string `json:"txHash"`
+	Status     string `json:"status"`
+	Reason     string `json:"reason,omitempty"`
+	ReplacedBy string `json:"replacedBy,omitempty"`
+	Height     uint32 `json:"height,omitempty"`
+	Timestamp  int64  `json:"timestamp"`
+}
+
+// TxnStatusHandler is called with each txn status update in the order they
+// happen
+type TxnStatusHandler func(*TxnStatusUpdate)
+
+// txnStatusTracker keeps status history of txns by txn hash. Status of a txn
+// expires after TxStatusRetention since its last update, and at most maxTxns
+// txns are tracked, evicting the ones least recently updated. Updates are
+// delivered to handlers from a single queue so that handlers see updates of a
+// txn in order.
+type txnStatusTracker struct {
+	sync.Mutex
+	retention time.Duration
+	maxTxns   int
+	txns      map[common.Uint256]*list.Element
+	order     *list.List // txnStatusEntry, least recently updated first
+	queue     chan *TxnStatusUpdate
+	handlers  []TxnStatusHandler
+}
+
+type txnStatusEntry struct {
+	hash      common.Uint256
+	history   []*TxnStatusUpdate
+	updatedAt time.Time
+}
+
+func newTxnStatusTracker(retention time.Duration, maxTxns int) *txnStatusTracker {
+	ts := &txnStatusTracker{
+		retention: retention,
+		maxTxns:   maxTxns,
+		txns:      make(map[common.Uint256]*list.Element),
+		order:     list.New(),
+		queue:     make(chan *TxnStatusUpdate, txnStatusQueueSize),
+	}
+	go ts.deliver()
+	go ts.cleanup()
+	return ts
+}
+
+func (ts *txnStatusTracker) subscribe(handler TxnStatusHandler) {
+	ts.Lock()
+	defer ts.Unlock()
+	ts.handlers = append(ts.handlers, handler)
+}
+
+func (ts *txnStatusTracker) deliver() {
+	for update := range ts.queue {
+		ts.Lock()
+		handlers := ts.handlers
+		ts.Unlock()
+		for _, handler := range handlers {
+			handler(update)
+		}
+	}
+}
+
+func (ts *txnStatusTracker) cleanup() {
+	for {
+		time.Sleep(txnStatusCleanupInterval)
+		ts.removeExpired(time.Now())
+	}
+}
+
+// removeExpired removes txns whose last update is retention before now.
+func (ts *txnStatusTracker) removeExpired(now time.Time) {
+	ts.Lock()
+	defer ts.Unlock()
+	for ts.order.Len() > 0 {
+		e := ts.order.Front()
+		entry := e.Value.(*txnStatusEntry)
+		if now.Sub(entry.updatedAt) < ts.retention {
+			break
+		}
+		ts.remove(e)
+	}
+}
+
+// remove removes a tracked txn. Caller should hold the lock.
+func (ts *txnStatusTracker) remove(e *list.Element) {
+	delete(ts.txns, e.Value.(*txnStatusEntry).hash)
+	ts.order.Remove(e)
+}
+
+func (ts *txnStatusTracker) get(hash common.Uint256) []*TxnStatusUpdate {
+	ts.Lock()
+	defer ts.Unlock()
+	e, ok := ts.txns[hash]
+	if !ok {
+		return nil
+	}
+	entry := e.Value.(*txnStatusEntry)
+	if time.Since(entry.updatedAt) >= ts.retention {
+		return nil
+	}
+	return entry.history
+}
+
+// update appends update to status history of txn. If onlyTracked is true,
+// update is ignored when txn is not tracked.
+func (ts *txnStatusTracker) update(hash common.Uint256, update *TxnStatusUpdate, onlyTracked bool) {
+	update.TxnHash = hash.ToHexString()
+
+	ts.Lock()
+	defer ts.Unlock()
+
+	now := time.Now()
+	update.Timestamp = now.UnixNano()
+
+	var entry *txnStatusEntry
+	if e, ok := ts.txns[hash]; ok && now.Sub(e.Value.(*txnStatusEntry).updatedAt) < ts.retention {
+		entry = e.Value.(*txnStatusEntry)
+		ts.order.MoveToBack(e)
+	} else {
+		if ok {
+			ts.remove(e)
+		}
+		if onlyTracked {
+			return
+		}
+		entry = &txnStatusEntry{hash: hash}
+		ts.txns[hash] = ts.order.PushBack(entry)
+		for ts.maxTxns > 0 && ts.order.Len() > ts.maxTxns {
+			ts.remove(ts.order.Front())
+		}
+	}
+
+	history := entry.history
+	if len(history) >= maxTxnStatusHistory {
+		history = history[len(history)-maxTxnStatusHistory+1:]
+	}
+	// copy on write so that history returned by get is not modified
+	newHistory := make([]*TxnStatusUpdate, 0, len(history)+1)
+	newHistory = append(newHistory, history...)
+	entry.history = append(newHistory, update)
+	entry.updatedAt = now
+
+	// enqueue while holding the lock so that queue order is the same as history
+	select {
+	case ts.queue <- update:
+	default:
+		log.Warningf("Txn status queue is full, drop status push of txn %s", update.TxnHash)
+	}
+}
+
+func isTrackedTxn(txn *transaction.Transaction) bool {
+	switch txn.UnsignedTx.Payload.Type {
+	case pb.COINBASE_TYPE, pb.SIG_CHAIN_TXN_TYPE:
+		return false
+	}
+	return true
+}
+
+// setTxnStatus records a status change of txn if txn status tracking is
+// enabled
+func (tp *TxnPool) setTxnStatus(txn *transaction.Transaction, update *TxnStatusUpdate) {
+	if tp.txnStatus == nil || !isTrackedTxn(txn) {
+		return
+	}
+	tp.txnStatus.update(txn.Hash(), update, false)
+}
+
+// GetTxnStatus returns the status history of a txn, oldest first. Returns nil
+// if txn is not tracked or its status has expired.
+func (tp *TxnPool) GetTxnStatus(hash common.Uint256) []*TxnStatusUpdate {
+	if tp.txnStatus == nil {
+		return nil
+	}
+	return tp.txnStatus.get(hash)
+}
+
+// SubscribeTxnStatus registers handler to be called with each txn status
+// update. It has no effect if txn status tracking is disabled.
+func (tp *TxnPool) SubscribeTxnStatus(handler TxnStatusHandler) {
+	if tp.txnStatus == nil {
+		return
+	}
+	tp.txnStatus.subscribe(handler)
+}
+
+// SetTxnsConfirmed marks txns in a block at height as confirmed. Txns that are
+// not tracked, e.g. never seen by local node, are ignored.
+func (tp *TxnPool) SetTxnsConfirmed(txns []*transaction.Transaction, height uint32) {
+	if tp.txnStatus == nil {
+		return
+	}
+	for _, txn := range txns {
+		if !isTrackedTxn(txn) {
+			continue
+		}
+		tp.txnStatus.update(txn.Hash(), &TxnStatusUpdate{Status: TxnStatusConfirmed, Height: height}, true)
+	}
+}
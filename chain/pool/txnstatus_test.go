@@ -0,0 +1,103 @@
+package pool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nknorg/nkn/common"
+)
+
+func TestTxnStatusTrackerUpdate(t *testing.T) {
+	hashes := make([]common.Uint256, 4)
+	for i := range hashes {
+		hashes[i][0] = byte(i + 1)
+	}
+
+	tests := []struct {
+		name    string
+		maxTxns int
+		updates []int // index of hash to update, in order
+		tracked []bool
+	}{
+		{"under limit", 4, []int{0, 1, 2, 3}, []bool{true, true, true, true}},
+		{"evict oldest", 2, []int{0, 1, 2, 3}, []bool{false, false, true, true}},
+		{"update refreshes order", 2, []int{0, 1, 0, 2}, []bool{true, false, true, false}},
+		{"no limit", 0, []int{0, 1, 2, 3}, []bool{true, true, true, true}},
+	}
+
+	for _, test := range tests {
+		ts := newTxnStatusTracker(time.Hour, test.maxTxns)
+		for _, i := range test.updates {
+			ts.update(hashes[i], &TxnStatusUpdate{Status: TxnStatusPending}, false)
+		}
+		for i, tracked := range test.tracked {
+			if got := ts.get(hashes[i]) != nil; got != tracked {
+				t.Errorf("%s: expect txn %d tracked %v, got %v", test.name, i, tracked, got)
+			}
+		}
+		if len(ts.txns) != ts.order.Len() {
+			t.Errorf("%s: expect %d txns in order list, got %d", test.name, len(ts.txns), ts.order.Len())
+		}
+	}
+}
+
+func TestTxnStatusTrackerRemoveExpired(t *testing.T) {
+	ts := newTxnStatusTracker(time.Hour, 0)
+	old, recent := common.Uint256{1}, common.Uint256{2}
+	ts.update(old, &TxnStatusUpdate{Status: TxnStatusPending}, false)
+	ts.update(recent, &TxnStatusUpdate{Status: TxnStatusPending}, false)
+	ts.txns[old].Value.(*txnStatusEntry).updatedAt = time.Now().Add(-2 * time.Hour)
+
+	if ts.get(old) != nil {
+		t.Errorf("expect expired txn not returned")
+	}
+	if ts.update(old, &TxnStatusUpdate{Status: TxnStatusConfirmed}, true); ts.get(old) != nil {
+		t.Errorf("expect expired txn not updated when only tracked")
+	}
+
+	ts.removeExpired(time.Now().Add(-30 * time.Minute))
+	if _, ok := ts.txns[recent]; !ok {
+		t.Errorf("expect recent txn kept")
+	}
+	ts.removeExpired(time.Now().Add(2 * time.Hour))
+	if len(ts.txns) != 0 || ts.order.Len() != 0 {
+		t.Errorf("expect all txns removed, got %d", len(ts.txns))
+	}
+}
+
+func TestAppendTxnPoolStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		local  bool
+		expect []string
+	}{
+		{"network", false, nil},
+		{"local", true, []string{TxnStatusReceived, TxnStatusRejected}},
+	}
+
+	for _, test := range tests {
+		// txn below min fee rate is rejected before touching ledger
+		tp := &TxnPool{txnStatus: newTxnStatusTracker(time.Hour, 0), minFeeRate: 1}
+		txn := newTestTxn(0, 0)
+		var err error
+		if test.local {
+			err = tp.AppendLocalTxn(txn)
+		} else {
+			err = tp.AppendTxnPool(txn)
+		}
+		if err == nil {
+			t.Errorf("%s: expect txn rejected", test.name)
+			continue
+		}
+		history := tp.GetTxnStatus(txn.Hash())
+		if len(history) != len(test.expect) {
+			t.Errorf("%s: expect %d status updates, got %d", test.name, len(test.expect), len(history))
+			continue
+		}
+		for i, update := range history {
+			if update.Status != test.expect[i] {
+				t.Errorf("%s: expect status %s, got %s", test.name, test.expect[i], update.Status)
+			}
+		}
+	}
+}
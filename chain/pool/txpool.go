@@ -4,6 +4,7 @@ import (
 	"container/heap"
 	"errors"
 	"fmt"
+	"math/big"
 	"sort"
 	"sync"
 	"sync/atomic"
@@ -11,26 +12,21 @@ import (
 
 	"github.com/nknorg/nkn/chain"
 	"github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/event"
 	"github.com/nknorg/nkn/pb"
 	"github.com/nknorg/nkn/transaction"
 	"github.com/nknorg/nkn/util/config"
 	"github.com/nknorg/nkn/util/log"
 )
 
+const (
+	// minFeeRateDecay is the factor that min fee rate decays by each cleanup
+	// interval when it's higher than the target
+	minFeeRateDecay = 0.5
+)
+
 func compareTxnPriority(txn1, txn2 *transaction.Transaction) int {
-	if txn1.UnsignedTx.Fee > txn2.UnsignedTx.Fee {
-		return 1
-	}
-	if txn1.UnsignedTx.Fee < txn2.UnsignedTx.Fee {
-		return -1
-	}
-	if txn1.GetSize() > txn2.GetSize() {
-		return -1
-	}
-	if txn1.GetSize() < txn2.GetSize() {
-		return 1
-	}
-	return 0
+	return chain.CompareTxnFeeRate(txn1, txn2)
 }
 
 type dropTxnsHeap []*transaction.Transaction
@@ -48,10 +44,50 @@ func (s *dropTxnsHeap) Pop() interface{} {
 }
 
 var (
-	ErrDuplicatedTx      = errors.New("duplicate transaction check failed")
-	ErrRejectLowPriority = errors.New("txpool full, rejecting transaction with low priority")
+	ErrDuplicatedTx       = errors.New("duplicate transaction check failed")
+	ErrRejectLowPriority  = errors.New("txpool full, rejecting transaction with low priority")
+	ErrReplaceUnderpriced = errors.New("replacement transaction fee is too low")
+	ErrFeeRateTooLow      = errors.New("transaction fee per byte is lower than txpool minimum")
 )
 
+// TxnReplacement is the value of TransactionReplaced event
+type TxnReplacement struct {
+	OldTxn *transaction.Transaction
+	NewTxn *transaction.Transaction
+}
+
+// checkReplacement checks if newTxn pays enough fee to replace oldTxn with the
+// same nonce. Both total fee and fee per byte of newTxn should be at least
+// TxPoolReplaceFeeBump percent higher than oldTxn.
+func checkReplacement(oldTxn, newTxn *transaction.Transaction) error {
+	bump := int64(config.Parameters.TxPoolReplaceFeeBump)
+	oldFee, newFee := oldTxn.UnsignedTx.Fee, newTxn.UnsignedTx.Fee
+	if bump > 0 && newFee <= oldFee {
+		return ErrReplaceUnderpriced
+	}
+
+	// products of fee and size may overflow int64
+	factor := big.NewInt(100 + bump)
+	hundred := big.NewInt(100)
+
+	// newFee >= oldFee * (100 + bump) / 100
+	minFee := new(big.Int).Mul(big.NewInt(oldFee), factor)
+	if new(big.Int).Mul(big.NewInt(newFee), hundred).Cmp(minFee) < 0 {
+		return ErrReplaceUnderpriced
+	}
+
+	// newFee / newSize >= oldFee / oldSize * (100 + bump) / 100
+	newRate := new(big.Int).Mul(big.NewInt(newFee), big.NewInt(int64(oldTxn.GetSize())))
+	newRate.Mul(newRate, hundred)
+	minRate := new(big.Int).Mul(big.NewInt(oldFee), big.NewInt(int64(newTxn.GetSize())))
+	minRate.Mul(minRate, factor)
+	if newRate.Cmp(minRate) < 0 {
+		return ErrReplaceUnderpriced
+	}
+
+	return nil
+}
+
 // TxnPool is a list of txns that need to by add to ledger sent by user.
 type TxnPool struct {
 	TxLists              sync.Map // NonceSortedTxs instance to store user's account.
@@ -64,6 +100,9 @@ type TxnPool struct {
 
 	sync.RWMutex
 	lastDroppedTxn *transaction.Transaction
+	minFeeRate     float64
+	journal        *txnJournal
+	txnStatus      *txnStatusTracker
 }
 
 func NewTxPool() *TxnPool {
@@ -72,9 +111,18 @@ func NewTxPool() *TxnPool {
 		txnCount:             0,
 	}
 
+	if config.Parameters.TxPoolJournal {
+		tp.journal = newTxnJournal(config.Parameters.TxPoolJournalPath)
+	}
+
+	if config.Parameters.TxStatusRetention > 0 {
+		tp.txnStatus = newTxnStatusTracker(config.Parameters.TxStatusRetention*time.Second, int(config.Parameters.TxStatusMaxTxns))
+	}
+
 	go func() {
 		for {
 			tp.DropTxns()
+			tp.updateMinFeeRate()
 			time.Sleep(config.TxPoolCleanupInterval)
 		}
 	}()
@@ -92,6 +140,88 @@ func isTxPoolFull(txnCount int32, txnSize int64) bool {
 	return false
 }
 
+// getTxPoolUsage returns the ratio of txpool usage to its limit, which is
+// full when not less than 1.
+func getTxPoolUsage(txnCount int32, txnSize int64) float64 {
+	var usage float64
+	if config.Parameters.TxPoolTotalTxCap > 0 {
+		usage = float64(txnCount) / float64(config.Parameters.TxPoolTotalTxCap)
+	}
+	if config.Parameters.TxPoolMaxMemorySize > 0 {
+		sizeUsage := float64(txnSize) / float64(int64(config.Parameters.TxPoolMaxMemorySize)*1024*1024)
+		if sizeUsage > usage {
+			usage = sizeUsage
+		}
+	}
+	return usage
+}
+
+// getLowestFeeRate returns the lowest fee per byte of txns that would be
+// dropped first when txpool is full.
+func (tp *TxnPool) getLowestFeeRate() float64 {
+	var lowest *transaction.Transaction
+	check := func(txn *transaction.Transaction) {
+		if lowest == nil || compareTxnPriority(txn, lowest) < 0 {
+			lowest = txn
+		}
+	}
+
+	tp.TxLists.Range(func(_, v interface{}) bool {
+		if list, ok := v.(*NonceSortedTxs); ok && list.Len() > 0 {
+			if txn, err := list.GetLatestTxn(); err == nil {
+				check(txn)
+			}
+		}
+		return true
+	})
+
+	tp.NanoPayTxs.Range(func(_, v interface{}) bool {
+		check(v.(*transaction.Transaction))
+		return true
+	})
+
+	if lowest == nil {
+		return 0
+	}
+
+	return chain.GetTxnFeeRate(lowest)
+}
+
+// updateMinFeeRate updates the dynamic min fee per byte to admit txns. When
+// txpool usage is above TxPoolFeeThreshold, the target rises from 0 to
+// the lowest fee rate in txpool as txpool approaches full. Min fee rate is
+// raised to the target immediately, and decays to the target gradually.
+func (tp *TxnPool) updateMinFeeRate() {
+	var target float64
+	threshold := float64(config.Parameters.TxPoolFeeThreshold) / 100
+	if threshold > 0 && threshold < 1 {
+		usage := getTxPoolUsage(atomic.LoadInt32(&tp.txnCount), atomic.LoadInt64(&tp.txnSize))
+		if usage > threshold {
+			ratio := (usage - threshold) / (1 - threshold)
+			if ratio > 1 {
+				ratio = 1
+			}
+			target = tp.getLowestFeeRate() * ratio
+		}
+	}
+
+	tp.Lock()
+	defer tp.Unlock()
+	if target < tp.minFeeRate*minFeeRateDecay {
+		tp.minFeeRate *= minFeeRateDecay
+	} else {
+		tp.minFeeRate = target
+	}
+}
+
+// GetMinFeeRate returns the current min fee per byte for a txn to be admitted
+// to txpool.
+func (tp *TxnPool) GetMinFeeRate() float64 {
+	tp.RLock()
+	defer tp.RUnlock()
+	return tp.minFeeRate
+}
+
 func (tp *TxnPool) getLastDroppedTxn() *transaction.Transaction {
 	tp.RLock()
 	defer tp.RUnlock()
@@ -208,6 +338,10 @@ func (tp *TxnPool) DropTxns() {
 	}
 	log.Infof("DropTxns: dropped %v txns (%v bytes)", len(txnsDropped), bytesDropped)
 
+	for _, txn := range txnsDropped {
+		tp.setTxnStatus(txn, &TxnStatusUpdate{Status: TxnStatusEvicted, Reason: "txpool full"})
+	}
+
 	if len(txnsDropped) > 0 {
 		tp.setLastDroppedTxn(txnsDropped[len(txnsDropped)-1])
 	} else {
@@ -217,12 +351,55 @@ func (tp *TxnPool) DropTxns() {
 	return
 }
 
+// AppendTxnPool verifies txn and adds it to txpool. Status of txn is tracked
+// once it's added to txpool unless it's already in txpool, so that invalid
+// txns from network do not take up status cache.
 func (tp *TxnPool) AppendTxnPool(txn *transaction.Transaction) error {
+	return tp.appendTxnPoolWithStatus(txn, false)
+}
+
+// AppendLocalTxn is the same as AppendTxnPool except that status of txn is
+// tracked from when it's received, including the reason if it's rejected. It
+// should be used for txns submitted to local node.
+func (tp *TxnPool) AppendLocalTxn(txn *transaction.Transaction) error {
+	return tp.appendTxnPoolWithStatus(txn, true)
+}
+
+func (tp *TxnPool) appendTxnPoolWithStatus(txn *transaction.Transaction, trackRejected bool) error {
+	if tp.txnStatus == nil || !isTrackedTxn(txn) || tp.GetTxnByHash(txn.Hash()) != nil {
+		return tp.appendTxnPool(txn)
+	}
+
+	if trackRejected {
+		tp.setTxnStatus(txn, &TxnStatusUpdate{Status: TxnStatusReceived})
+	}
+
+	err := tp.appendTxnPool(txn)
+	if err != nil {
+		if trackRejected {
+			tp.setTxnStatus(txn, &TxnStatusUpdate{Status: TxnStatusRejected, Reason: err.Error()})
+		}
+		return err
+	}
+
+	if !trackRejected {
+		tp.setTxnStatus(txn, &TxnStatusUpdate{Status: TxnStatusReceived})
+	}
+	tp.setTxnStatus(txn, &TxnStatusUpdate{Status: TxnStatusPending})
+
+	return nil
+}
+
+func (tp *TxnPool) appendTxnPool(txn *transaction.Transaction) error {
 	lastDroppedTxn := tp.getLastDroppedTxn()
 	if lastDroppedTxn != nil && compareTxnPriority(txn, lastDroppedTxn) <= 0 {
 		return ErrRejectLowPriority
 	}
 
+	if txn.UnsignedTx.Payload.Type != pb.SIG_CHAIN_TXN_TYPE && chain.GetTxnFeeRate(txn) < tp.GetMinFeeRate() {
+		return ErrFeeRateTooLow
+	}
+
 	sender, err := txn.GetProgramHashes()
 	if err != nil {
 		return err
@@ -252,6 +429,12 @@ func (tp *TxnPool) AppendTxnPool(txn *transaction.Transaction) error {
 		return err
 	}
 
+	if txn.UnsignedTx.Payload.Type != pb.SIG_CHAIN_TXN_TYPE {
+		event.Queue.Notify(event.NewTransactionAdded, txn)
+	}
+
+	tp.journalTxn(txn)
+
 	return nil
 }
 
@@ -312,6 +495,10 @@ func (tp *TxnPool) processTx(txn *transaction.Transaction) error {
 		tp.blockValidationState.Commit()
 	default:
 		if oldTxn, err := list.GetByNonce(txn.UnsignedTx.Nonce); err == nil {
+			if err := checkReplacement(oldTxn, txn); err != nil {
+				return err
+			}
+
 			log.Debug("replace old tx")
 			tp.blockValidationState.Lock()
 			defer tp.blockValidationState.Unlock()
@@ -330,6 +517,9 @@ func (tp *TxnPool) processTx(txn *transaction.Transaction) error {
 			tp.deleteTransactionFromMap(oldTxn)
 			atomic.AddInt32(&tp.txnCount, -1)
 			atomic.AddInt64(&tp.txnSize, -int64(oldTxn.GetSize()))
+
+			event.Queue.Notify(event.TransactionReplaced, &TxnReplacement{OldTxn: oldTxn, NewTxn: txn})
+			tp.setTxnStatus(oldTxn, &TxnStatusUpdate{Status: TxnStatusReplaced, ReplacedBy: hash.ToHexString()})
 		} else if list.Full() {
 			return errors.New("txpool per account list is full")
 		} else {
@@ -524,9 +714,11 @@ func (tp *TxnPool) GetAllTransactionLists() map[common.Uint160][]*transaction.Tr
 
 func (tp *TxnPool) CleanSubmittedTransactions(txns []*transaction.Transaction) error {
 	txnsRemoved := make([]*transaction.Transaction, 0)
+	submitted := make(map[common.Uint256]struct{}, len(txns))
 
 	// clean submitted txs
 	for _, txn := range txns {
+		submitted[txn.Hash()] = struct{}{}
 		txnsToRemove := make([]*transaction.Transaction, 0)
 
 		switch txn.UnsignedTx.Payload.Type {
@@ -562,6 +754,13 @@ func (tp *TxnPool) CleanSubmittedTransactions(txns []*transaction.Transaction) e
 		}
 	}
 
+	// txns removed but not in block have nonce used by another txn
+	for _, txn := range txnsRemoved {
+		if _, ok := submitted[txn.Hash()]; !ok {
+			tp.setTxnStatus(txn, &TxnStatusUpdate{Status: TxnStatusEvicted, Reason: "nonce is used by another transaction"})
+		}
+	}
+
 	tp.TxLists.Range(func(k, v interface{}) bool {
 		if v.(*NonceSortedTxs).CleanIfEmpty() {
 			tp.TxLists.Delete(k)
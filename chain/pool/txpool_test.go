@@ -0,0 +1,109 @@
+package pool
+
+import (
+	"math"
+	"testing"
+
+	"github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/config"
+)
+
+func newTestTxn(fee int64, attrSize int) *transaction.Transaction {
+	payload, _ := transaction.Pack(pb.TRANSFER_ASSET_TYPE, transaction.NewTransferAsset(common.Uint160{}, common.Uint160{}, 1))
+	return &transaction.Transaction{
+		Transaction: transaction.NewMsgTx(payload, 0, common.Fixed64(fee), make([]byte, attrSize)),
+	}
+}
+
+func TestCheckReplacement(t *testing.T) {
+	bump := config.Parameters.TxPoolReplaceFeeBump
+	defer func() { config.Parameters.TxPoolReplaceFeeBump = bump }()
+	config.Parameters.TxPoolReplaceFeeBump = 10
+
+	old := newTestTxn(1000, 0)
+	large := newTestTxn(1000, int(old.GetSize()))
+	maxFee := newTestTxn(math.MaxInt64, 0)
+
+	tests := []struct {
+		name   string
+		oldTxn *transaction.Transaction
+		newTxn *transaction.Transaction
+		ok     bool
+	}{
+		{"exactly bump", old, newTestTxn(1100, 0), true},
+		{"one below bump", old, newTestTxn(1099, 0), false},
+		{"same fee", old, newTestTxn(1000, 0), false},
+		{"lower fee", old, newTestTxn(999, 0), false},
+		{"higher fee worse fee rate", old, newTestTxn(2000, int(large.GetSize())), false},
+		{"higher fee same fee rate", newTestTxn(1000, 0), newTestTxn(1100, 0), true},
+		{"zero fee", newTestTxn(0, 0), newTestTxn(0, 0), false},
+		{"zero fee bumped", newTestTxn(0, 0), newTestTxn(1, 0), true},
+		{"large fee", newTestTxn(math.MaxInt64/2, 0), maxFee, true},
+		{"large fee below bump", newTestTxn(math.MaxInt64-10, 0), maxFee, false},
+	}
+
+	for _, test := range tests {
+		err := checkReplacement(test.oldTxn, test.newTxn)
+		if test.ok && err != nil {
+			t.Errorf("%s: expect ok, got %v", test.name, err)
+		}
+		if !test.ok && err != ErrReplaceUnderpriced {
+			t.Errorf("%s: expect %v, got %v", test.name, ErrReplaceUnderpriced, err)
+		}
+	}
+
+	config.Parameters.TxPoolReplaceFeeBump = 0
+	if err := checkReplacement(old, newTestTxn(1000, 0)); err != nil {
+		t.Errorf("same fee without bump: expect ok, got %v", err)
+	}
+}
+
+func TestUpdateMinFeeRate(t *testing.T) {
+	totalTxCap, maxMemorySize, threshold := config.Parameters.TxPoolTotalTxCap, config.Parameters.TxPoolMaxMemorySize, config.Parameters.TxPoolFeeThreshold
+	defer func() {
+		config.Parameters.TxPoolTotalTxCap = totalTxCap
+		config.Parameters.TxPoolMaxMemorySize = maxMemorySize
+		config.Parameters.TxPoolFeeThreshold = threshold
+	}()
+	config.Parameters.TxPoolTotalTxCap = 100
+	config.Parameters.TxPoolMaxMemorySize = 0
+	config.Parameters.TxPoolFeeThreshold = 50
+
+	tp := &TxnPool{}
+	low, high := newTestTxn(1000, 0), newTestTxn(3000, 0)
+	tp.NanoPayTxs.Store(low.Hash(), low)
+	tp.NanoPayTxs.Store(high.Hash(), high)
+	lowest := float64(1000) / float64(low.GetSize())
+
+	tests := []struct {
+		name       string
+		txnCount   int32
+		minFeeRate float64
+	}{
+		{"empty", 0, 0},
+		{"at threshold", 50, 0},
+		{"half way to full", 75, lowest * 0.5},
+		{"full", 100, lowest},
+		{"decay after empty", 0, lowest * minFeeRateDecay},
+		{"decay again", 0, lowest * minFeeRateDecay * minFeeRateDecay},
+		{"raise above decayed", 90, lowest * 0.8},
+		{"over full", 150, lowest},
+	}
+
+	for _, test := range tests {
+		tp.txnCount = test.txnCount
+		tp.updateMinFeeRate()
+		if minFeeRate := tp.GetMinFeeRate(); math.Abs(minFeeRate-test.minFeeRate) > 1e-9 {
+			t.Errorf("%s: expect min fee rate %v, got %v", test.name, test.minFeeRate, minFeeRate)
+		}
+	}
+
+	config.Parameters.TxPoolFeeThreshold = 0
+	tp.txnCount = 100
+	tp.updateMinFeeRate()
+	if minFeeRate := tp.GetMinFeeRate(); math.Abs(minFeeRate-lowest*minFeeRateDecay) > 1e-9 {
+		t.Errorf("disabled threshold: expect min fee rate to decay to %v, got %v", lowest*minFeeRateDecay, minFeeRate)
+	}
+}
@@ -0,0 +1,258 @@
+package store
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/chain/db"
+	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/crypto"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/program"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/util/log"
+)
+
+func pubKeyToProgramHash(pubKey []byte) (Uint160, error) {
+	publicKey, err := crypto.NewPubKeyFromBytes(pubKey)
+	if err != nil {
+		return EmptyUint160, err
+	}
+
+	return program.CreateProgramHash(publicKey)
+}
+
+// getTxnAddresses returns all program hashes involved in a transaction,
+// including sender, recipient, registrant, subscriber and nanopay parties.
+func getTxnAddresses(txn *transaction.Transaction) ([]Uint160, error) {
+	payload, err := transaction.Unpack(txn.UnsignedTx.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var addrs []Uint160
+	var pubKeys [][]byte
+
+	switch txn.UnsignedTx.Payload.Type {
+	case pb.COINBASE_TYPE:
+		pld := payload.(*pb.Coinbase)
+		addrs = append(addrs, BytesToUint160(pld.Sender), BytesToUint160(pld.Recipient))
+	case pb.SIG_CHAIN_TXN_TYPE:
+		pld := payload.(*pb.SigChainTxn)
+		addrs = append(addrs, BytesToUint160(pld.Submitter))
+	case pb.TRANSFER_ASSET_TYPE:
+		pld := payload.(*pb.TransferAsset)
+		addrs = append(addrs, BytesToUint160(pld.Sender), BytesToUint160(pld.Recipient))
+	case pb.ISSUE_ASSET_TYPE:
+		pld := payload.(*pb.IssueAsset)
+		addrs = append(addrs, BytesToUint160(pld.Sender))
+	case pb.REGISTER_NAME_TYPE:
+		pubKeys = append(pubKeys, payload.(*pb.RegisterName).Registrant)
+	case pb.TRANSFER_NAME_TYPE:
+		pld := payload.(*pb.TransferName)
+		pubKeys = append(pubKeys, pld.Registrant, pld.Recipient)
+	case pb.DELETE_NAME_TYPE:
+		pubKeys = append(pubKeys, payload.(*pb.DeleteName).Registrant)
+	case pb.SUBSCRIBE_TYPE:
+		pubKeys = append(pubKeys, payload.(*pb.Subscribe).Subscriber)
+	case pb.UNSUBSCRIBE_TYPE:
+		pubKeys = append(pubKeys, payload.(*pb.Unsubscribe).Subscriber)
+	case pb.GENERATE_ID_TYPE:
+		pubKeys = append(pubKeys, payload.(*pb.GenerateID).PublicKey)
+	case pb.NANO_PAY_TYPE:
+		pld := payload.(*pb.NanoPay)
+		addrs = append(addrs, BytesToUint160(pld.Sender), BytesToUint160(pld.Recipient))
+	default:
+		return nil, errors.New("unsupported transaction type")
+	}
+
+	for _, pk := range pubKeys {
+		programHash, err := pubKeyToProgramHash(pk)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, programHash)
+	}
+
+	unique := make([]Uint160, 0, len(addrs))
+	seen := make(map[Uint160]struct{}, len(addrs))
+	for _, addr := range addrs {
+		if _, ok := seen[addr]; ok {
+			continue
+		}
+		seen[addr] = struct{}{}
+		unique = append(unique, addr)
+	}
+
+	return unique, nil
+}
+
+func (cs *ChainStore) batchPutAddressTxIndex(txn *transaction.Transaction, height uint32) error {
+	addrs, err := getTxnAddresses(txn)
+	if err != nil {
+		return err
+	}
+
+	txHash := txn.Hash()
+	for _, addr := range addrs {
+		if err := cs.st.BatchPut(db.AddressTransactionKey(addr, height, txHash), []byte{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cs *ChainStore) batchDeleteAddressTxIndex(txn *transaction.Transaction, height uint32) error {
+	addrs, err := getTxnAddresses(txn)
+	if err != nil {
+		return err
+	}
+
+	txHash := txn.Hash()
+	for _, addr := range addrs {
+		if err := cs.st.BatchDelete(db.AddressTransactionKey(addr, height, txHash)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func encodeHeight(height uint32) []byte {
+	heightBuffer := make([]byte, 4)
+	binary.LittleEndian.PutUint32(heightBuffer, height)
+	return heightBuffer
+}
+
+// getAddressTxIndexStartHeight returns the height from which address
+// transaction index is complete.
+func (cs *ChainStore) getAddressTxIndexStartHeight() (uint32, error) {
+	heightBuffer, err := cs.st.Get(db.AddressTransactionHeightKey())
+	if err != nil {
+		return 0, err
+	}
+	if len(heightBuffer) != 4 {
+		return 0, errors.New("invalid address transaction index height")
+	}
+	return binary.LittleEndian.Uint32(heightBuffer), nil
+}
+
+// initAddressTxIndex records the height from which address transaction index
+// is complete, and starts to backfill index of blocks before it. Blocks added
+// when index is disabled are not indexed, so start height is cleared and index
+// starts from the next block when enabled again.
+func (cs *ChainStore) initAddressTxIndex() error {
+	if !config.Parameters.AddressTxIndex {
+		return cs.st.Delete(db.AddressTransactionHeightKey())
+	}
+
+	startHeight, err := cs.getAddressTxIndexStartHeight()
+	if err != nil {
+		startHeight = cs.GetHeight() + 1
+		if err = cs.st.Put(db.AddressTransactionHeightKey(), encodeHeight(startHeight)); err != nil {
+			return err
+		}
+	}
+
+	if startHeight > 0 {
+		go cs.backfillAddressTxIndex(startHeight)
+	}
+
+	return nil
+}
+
+// backfillAddressTxIndex builds address transaction index of blocks before
+// startHeight from newest to oldest, until genesis block or a block not in
+// local ledger is reached.
+func (cs *ChainStore) backfillAddressTxIndex(startHeight uint32) {
+	log.Infof("Start to backfill address transaction index before height %d", startHeight)
+
+	for height := startHeight; height > 0; height-- {
+		// start height might be changed by state sync
+		if h, err := cs.getAddressTxIndexStartHeight(); err != nil || h != height {
+			return
+		}
+
+		b, err := cs.GetBlockByHeight(height - 1)
+		if err != nil {
+			log.Infof("Stop backfilling address transaction index at height %d: %v", height, err)
+			return
+		}
+
+		for _, txn := range b.Transactions {
+			addrs, err := getTxnAddresses(txn)
+			if err != nil {
+				log.Errorf("Backfill address transaction index error: %v", err)
+				return
+			}
+			txHash := txn.Hash()
+			for _, addr := range addrs {
+				if err = cs.st.Put(db.AddressTransactionKey(addr, height-1, txHash), []byte{}); err != nil {
+					log.Errorf("Backfill address transaction index error: %v", err)
+					return
+				}
+			}
+		}
+
+		if err = cs.st.Put(db.AddressTransactionHeightKey(), encodeHeight(height-1)); err != nil {
+			log.Errorf("Backfill address transaction index error: %v", err)
+			return
+		}
+	}
+
+	log.Info("Address transaction index backfilled to genesis block")
+}
+
+// GetTransactionsByAddr returns hashes of transactions involving addr, newest
+// first. A limit of 0 means no limit. Returns chain.ErrHistoryUnavailable if
+// the requested page reaches blocks before the height from which index is
+// complete.
+func (cs *ChainStore) GetTransactionsByAddr(addr Uint160, offset, limit uint32) ([]Uint256, error) {
+	startHeight, err := cs.getAddressTxIndexStartHeight()
+	if err != nil {
+		return nil, chain.ErrHistoryUnavailable
+	}
+
+	prefix := db.AddressTransactionPrefix(addr)
+	iter := cs.st.NewIterator(prefix)
+	defer iter.Release()
+
+	hashes := make([]Uint256, 0)
+	i := uint32(0)
+	for ok := iter.Last(); ok; ok = iter.Prev() {
+		if limit > 0 && i >= offset+limit {
+			break
+		}
+
+		key := iter.Key()
+		if len(key) != len(prefix)+4+UINT256SIZE {
+			return nil, errors.New("invalid address transaction index key")
+		}
+
+		// index before start height might be incomplete
+		if binary.BigEndian.Uint32(key[len(prefix):len(prefix)+4]) < startHeight {
+			break
+		}
+
+		if i < offset {
+			i++
+			continue
+		}
+		i++
+
+		txHash, err := Uint256ParseFromBytes(key[len(prefix)+4:])
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, txHash)
+	}
+
+	if startHeight > 0 && (limit == 0 || i < offset+limit) {
+		return nil, chain.ErrHistoryUnavailable
+	}
+
+	return hashes, nil
+}
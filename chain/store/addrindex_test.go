@@ -0,0 +1,72 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/chain/db"
+	. "github.com/nknorg/nkn/common"
+)
+
+func TestGetTransactionsByAddr(t *testing.T) {
+	st, cleanup := newTestStore(t)
+	defer cleanup()
+
+	cs := &ChainStore{st: st}
+	addr := Uint160{1}
+
+	// one transaction at each height from 1 to 5
+	for height := uint32(1); height <= 5; height++ {
+		if err := st.Put(db.AddressTransactionKey(addr, height, Uint256{byte(height)}), []byte{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		startHeight uint32
+		offset      uint32
+		limit       uint32
+		heights     []byte
+		err         error
+	}{
+		{"complete index", 0, 0, 0, []byte{5, 4, 3, 2, 1}, nil},
+		{"first page", 0, 0, 2, []byte{5, 4}, nil},
+		{"last page", 0, 4, 2, []byte{1}, nil},
+		{"after last page", 0, 5, 2, []byte{}, nil},
+		{"page above start height", 3, 0, 2, []byte{5, 4}, nil},
+		{"page ends at start height", 3, 1, 2, []byte{4, 3}, nil},
+		{"page reaches below start height", 3, 2, 2, nil, chain.ErrHistoryUnavailable},
+		{"no limit with start height", 3, 0, 0, nil, chain.ErrHistoryUnavailable},
+	}
+
+	for _, test := range tests {
+		if err := st.Put(db.AddressTransactionHeightKey(), encodeHeight(test.startHeight)); err != nil {
+			t.Fatal(err)
+		}
+		hashes, err := cs.GetTransactionsByAddr(addr, test.offset, test.limit)
+		if err != test.err {
+			t.Errorf("%s: expect error %v, got %v", test.name, test.err, err)
+			continue
+		}
+		if test.err != nil {
+			continue
+		}
+		if len(hashes) != len(test.heights) {
+			t.Errorf("%s: expect %d transactions, got %d", test.name, len(test.heights), len(hashes))
+			continue
+		}
+		for i, hash := range hashes {
+			if hash != (Uint256{test.heights[i]}) {
+				t.Errorf("%s: expect transaction %d at index %d, got %v", test.name, test.heights[i], i, hash)
+			}
+		}
+	}
+
+	if err := st.Delete(db.AddressTransactionHeightKey()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cs.GetTransactionsByAddr(addr, 0, 1); err != chain.ErrHistoryUnavailable {
+		t.Errorf("index without start height: expect error %v, got %v", chain.ErrHistoryUnavailable, err)
+	}
+}
@@ -0,0 +1,52 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/nknorg/nkn/chain"
+	. "github.com/nknorg/nkn/common"
+)
+
+// GetStateReaderAtHeight returns a read-only view of the states after the block
+// at height is applied. Returns chain.ErrStatePruned if the states at height
+// have been pruned.
+func (cs *ChainStore) GetStateReaderAtHeight(height uint32) (chain.IStateReader, error) {
+	currentHeight := cs.GetHeight()
+	if height > currentHeight {
+		return nil, fmt.Errorf("height %d is higher than current height %d", height, currentHeight)
+	}
+
+	if height == currentHeight {
+		return cs, nil
+	}
+
+	_, pruningStartHeight := cs.getPruningStartHeight()
+	if pruningStartHeight > 0 && height < pruningStartHeight {
+		return nil, chain.ErrStatePruned
+	}
+
+	header, err := cs.GetHeaderByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := Uint256ParseFromBytes(header.UnsignedHeader.StateRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	states, err := NewStateDB(root, cs)
+	if err != nil {
+		// root node is missing if the states are pruned or not synced
+		return nil, chain.ErrStatePruned
+	}
+
+	return &ChainStore{
+		st:                 cs.st,
+		blockCache:         cs.blockCache,
+		headerCache:        cs.headerCache,
+		States:             states,
+		currentBlockHash:   header.Hash(),
+		currentBlockHeight: height,
+	}, nil
+}
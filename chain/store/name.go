@@ -1,6 +1,7 @@
 package store
 
 import (
+	"bytes"
 	"strings"
 )
 
@@ -44,17 +45,39 @@ func (sdb *StateDB) deleteName(registrantId string) error {
 		return err
 	}
 
-	err = sdb.trie.TryDelete(append(NamePrefix, nameId...))
+	// name might have been transferred to and updated for a new registrant
+	registrant, err := sdb.trie.TryGet(append(NamePrefix, nameId...))
 	if err != nil {
 		return err
 	}
 
+	if bytes.Equal(registrant, []byte(registrantId)) {
+		err = sdb.trie.TryDelete(append(NamePrefix, nameId...))
+		if err != nil {
+			return err
+		}
+	}
+
 	sdb.names.Delete(registrantId)
-	sdb.nameRegistrants.Delete(nameId)
+	if v, ok := sdb.nameRegistrants.Load(nameId); ok {
+		if registrant, ok := v.([]byte); !ok || len(registrant) == 0 || bytes.Equal(registrant, []byte(registrantId)) {
+			sdb.nameRegistrants.Delete(nameId)
+		}
+	}
 
 	return nil
 }
 
+func (sdb *StateDB) transferName(registrant, recipient []byte, name string) {
+	registrantId := getRegistrantId(registrant)
+	recipientId := getRegistrantId(recipient)
+	nameId := getNameId(name)
+
+	sdb.names.Store(registrantId, "")
+	sdb.names.Store(recipientId, name)
+	sdb.nameRegistrants.Store(nameId, recipient)
+}
+
 func (sdb *StateDB) deleteNameForRegistrant(registrant []byte, name string) {
 	registrantId := getRegistrantId(registrant)
 	nameId := getNameId(name)
@@ -0,0 +1,69 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/chain/trie"
+	. "github.com/nknorg/nkn/common"
+)
+
+// AccountStateKey returns the state trie key of account addr.
+func AccountStateKey(addr Uint160) []byte {
+	return append(AccountPrefix, addr[:]...)
+}
+
+// NameStateKey returns the state trie key whose value is the registrant of
+// name.
+func NameStateKey(name string) []byte {
+	return append(NamePrefix, getNameId(name)...)
+}
+
+// PubSubStateKey returns the state trie key of a subscription.
+func PubSubStateKey(topic string, bucket uint32, subscriber []byte, identifier string) []byte {
+	return append(PubSubPrefix, getPubSubId(topic, bucket, subscriber, identifier)...)
+}
+
+// GetStateProof returns the value of key in the state trie at block height
+// together with its merkle proof, which can be verified by trie.VerifyProof
+// against the returned state root. Returns chain.ErrStatePruned if states at
+// height are not available in local ledger.
+func (cs *ChainStore) GetStateProof(key []byte, height uint32) (Uint256, []byte, [][]byte, error) {
+	currentHeight := cs.GetHeight()
+	if height > currentHeight {
+		return EmptyUint256, nil, nil, fmt.Errorf("height %d is higher than current height %d", height, currentHeight)
+	}
+
+	_, pruningStartHeight := cs.getPruningStartHeight()
+	if height < currentHeight && pruningStartHeight > 0 && height < pruningStartHeight {
+		return EmptyUint256, nil, nil, chain.ErrStatePruned
+	}
+
+	header, err := cs.GetHeaderByHeight(height)
+	if err != nil {
+		return EmptyUint256, nil, nil, err
+	}
+
+	root, err := Uint256ParseFromBytes(header.UnsignedHeader.StateRoot)
+	if err != nil {
+		return EmptyUint256, nil, nil, err
+	}
+
+	// trie nodes are missing if the states are pruned or not synced
+	tr, err := trie.New(root, cs.st)
+	if err != nil {
+		return EmptyUint256, nil, nil, chain.ErrStatePruned
+	}
+
+	value, err := tr.TryGet(key)
+	if err != nil {
+		return EmptyUint256, nil, nil, chain.ErrStatePruned
+	}
+
+	proof, err := tr.Prove(key)
+	if err != nil {
+		return EmptyUint256, nil, nil, chain.ErrStatePruned
+	}
+
+	return root, value, proof, nil
+}
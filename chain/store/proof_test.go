@@ -0,0 +1,82 @@
+package store
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/nknorg/nkn/block"
+	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/chain/db"
+	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/common/serialization"
+	"github.com/nknorg/nkn/pb"
+)
+
+func putTestHeader(t *testing.T, st *db.LevelDBStore, height uint32, stateRoot Uint256) {
+	header := &block.Header{Header: &pb.Header{UnsignedHeader: &pb.UnsignedHeader{
+		Height:    height,
+		StateRoot: stateRoot.ToArray(),
+	}}}
+	b, err := header.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := bytes.NewBuffer(nil)
+	if err = serialization.WriteVarBytes(buf, b); err != nil {
+		t.Fatal(err)
+	}
+	hash := header.Hash()
+	if err = st.Put(db.HeaderKey(hash), buf.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if err = st.Put(db.BlockhashKey(height), hash.ToArray()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetStateProofErrors(t *testing.T) {
+	st, cleanup := newTestStore(t)
+	defer cleanup()
+
+	heightBuffer := make([]byte, 4)
+	binary.LittleEndian.PutUint32(heightBuffer, 2)
+	if err := st.Put(db.TrieRefCountHeightKey(), heightBuffer); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.Put(db.TriePrunedHeightKey(), heightBuffer); err != nil {
+		t.Fatal(err)
+	}
+
+	root := commitTestTrie(t, st, "key", 10)
+	putTestHeader(t, st, 2, root)
+	putTestHeader(t, st, 3, Uint256{1}) // root node missing
+	putTestHeader(t, st, 4, root)
+
+	cs := &ChainStore{st: st, currentBlockHeight: 4}
+
+	tests := []struct {
+		name   string
+		height uint32
+		err    error
+	}{
+		{"before pruning start", 2, chain.ErrStatePruned},
+		{"missing root node", 3, chain.ErrStatePruned},
+		{"available", 4, nil},
+	}
+
+	for _, test := range tests {
+		r, value, _, err := cs.GetStateProof([]byte("key1"), test.height)
+		if err != test.err {
+			t.Errorf("%s: expect error %v, got %v", test.name, test.err, err)
+			continue
+		}
+		if err == nil && (r != root || string(value) != "value1") {
+			t.Errorf("%s: expect value1 under root %v, got %q under %v", test.name, root, value, r)
+		}
+	}
+
+	if _, _, _, err := cs.GetStateProof([]byte("key1"), 5); err == nil {
+		t.Errorf("expect error for height higher than current height")
+	}
+}
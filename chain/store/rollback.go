@@ -2,7 +2,9 @@ package store
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
+	"fmt"
 
 	"github.com/nknorg/nkn/block"
 	"github.com/nknorg/nkn/chain/db"
@@ -58,6 +60,86 @@ func (cs *ChainStore) Rollback(b *block.Block) error {
 	return nil
 }
 
+// CheckRollbackToHeight checks if local ledger can be rolled back to height,
+// which requires states at height not being pruned.
+func (cs *ChainStore) CheckRollbackToHeight(height uint32) error {
+	currentHeight := cs.GetHeight()
+	if height >= currentHeight {
+		return fmt.Errorf("rollback height %d is not lower than current height %d", height, currentHeight)
+	}
+
+	_, pruningStartHeight := cs.getPruningStartHeight()
+	if pruningStartHeight > 0 && height < pruningStartHeight {
+		return fmt.Errorf("states before height %d have been pruned, cannot rollback to height %d", pruningStartHeight, height)
+	}
+
+	header, err := cs.GetHeaderByHeight(height)
+	if err != nil {
+		return err
+	}
+
+	root, err := common.Uint256ParseFromBytes(header.UnsignedHeader.StateRoot)
+	if err != nil {
+		return err
+	}
+
+	if _, err = NewStateDB(root, cs); err != nil {
+		return fmt.Errorf("states at height %d are not available: %v", height, err)
+	}
+
+	return nil
+}
+
+// RollbackToHeight rolls back blocks one by one from current height until
+// current height becomes height. progress, if not nil, is called after each
+// block is rolled back. It should only be called when node is not running.
+func (cs *ChainStore) RollbackToHeight(height uint32, progress func(b *block.Block)) error {
+	if err := cs.CheckRollbackToHeight(height); err != nil {
+		return err
+	}
+
+	for cs.GetHeight() > height {
+		b, err := cs.GetBlockByHeight(cs.GetHeight())
+		if err != nil {
+			return fmt.Errorf("get block at height %d error: %v", cs.GetHeight(), err)
+		}
+
+		if err = cs.Rollback(b); err != nil {
+			return fmt.Errorf("rollback block at height %d error: %v", b.Header.UnsignedHeader.Height, err)
+		}
+
+		prevHash, err := common.Uint256ParseFromBytes(b.Header.UnsignedHeader.PrevBlockHash)
+		if err != nil {
+			return err
+		}
+
+		// Rollback does not touch in memory height which is managed by ledger
+		// when node is running, so update it here to continue with the next
+		// block. This is only used offline when ledger is not running.
+		cs.mu.Lock()
+		cs.currentBlockHeight = b.Header.UnsignedHeader.Height - 1
+		cs.currentBlockHash = prevHash
+		cs.mu.Unlock()
+
+		if progress != nil {
+			progress(b)
+		}
+	}
+
+	// states of rolled back blocks might have been ref counted, so ref counting
+	// should restart from the new blocks after height
+	refCountStartHeight, _ := cs.getPruningStartHeight()
+	if refCountStartHeight > height+1 {
+		heightBuffer := make([]byte, 4)
+		binary.LittleEndian.PutUint32(heightBuffer, height)
+		if err := cs.st.Put(db.TrieRefCountHeightKey(), heightBuffer); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (cs *ChainStore) rollbackHeader(b *block.Block) error {
 	blockHash := b.Hash()
 	return cs.st.BatchDelete(db.HeaderKey(blockHash))
@@ -69,6 +151,12 @@ func (cs *ChainStore) rollbackTransaction(b *block.Block) error {
 		if err := cs.st.BatchDelete(db.TransactionKey(txHash)); err != nil {
 			return err
 		}
+
+		// index might be built when AddressTxIndex was enabled before, so
+		// always clean it up regardless of current config
+		if err := cs.batchDeleteAddressTxIndex(txn, b.Header.UnsignedHeader.Height); err != nil {
+			return err
+		}
 	}
 
 	return nil
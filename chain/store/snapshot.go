@@ -0,0 +1,289 @@
+package store
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/nknorg/nkn/block"
+	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/chain/db"
+	"github.com/nknorg/nkn/chain/trie"
+	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/common/serialization"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/util/log"
+)
+
+func (cs *ChainStore) GetStateNode(hash []byte) ([]byte, error) {
+	return cs.st.Get(db.TrieNodeKey(hash))
+}
+
+func (cs *ChainStore) HasStateNode(hash []byte) bool {
+	has, err := cs.st.Has(db.TrieNodeKey(hash))
+	return err == nil && has
+}
+
+// PutStateNode verifies and saves a state node synced from neighbors.
+// StartStateSync should be called before putting any synced state node.
+func (cs *ChainStore) PutStateNode(hash, node []byte) error {
+	if !trie.VerifyNode(hash, node) {
+		return fmt.Errorf("state node does not match hash %x", hash)
+	}
+	return cs.st.Put(db.TrieNodeKey(hash), node)
+}
+
+// StartStateSync records root as the state root being synced before state
+// nodes are put into store. Nodes of an unfinished sync might miss some
+// descendants, so they are removed by cleanupStateSync before the next sync
+// starts or when ledger is loaded.
+func (cs *ChainStore) StartStateSync(root Uint256) error {
+	if err := cs.cleanupStateSync(); err != nil {
+		return err
+	}
+	return cs.st.Put(db.TrieSyncRootKey(), root.ToArray())
+}
+
+// cleanupStateSync removes all state nodes not reachable from current state
+// root if there is an unfinished state sync. State sync only starts from
+// genesis block, so current states are small enough to be traversed in memory.
+func (cs *ChainStore) cleanupStateSync() error {
+	syncRoot, err := cs.st.Get(db.TrieSyncRootKey())
+	if err != nil {
+		return nil
+	}
+
+	log.Warningf("Remove state nodes of unfinished state sync of root %x", syncRoot)
+
+	root, err := cs.GetCurrentBlockStateRoot()
+	if err != nil {
+		return err
+	}
+
+	reachable := make(map[string]struct{})
+	if root != EmptyUint256 {
+		pending := [][]byte{root.ToArray()}
+		for len(pending) > 0 {
+			hash := pending[len(pending)-1]
+			pending = pending[:len(pending)-1]
+			if _, ok := reachable[string(hash)]; ok {
+				continue
+			}
+
+			node, err := cs.GetStateNode(hash)
+			if err != nil {
+				return fmt.Errorf("get state node %x error: %v", hash, err)
+			}
+			reachable[string(hash)] = struct{}{}
+
+			children, err := trie.ChildrenHashes(node)
+			if err != nil {
+				return err
+			}
+			pending = append(pending, children...)
+		}
+	}
+
+	prefix := db.TrieNodePrefix()
+	iter := cs.st.NewIterator(prefix)
+	defer iter.Release()
+
+	numRemoved := 0
+	for iter.Next() {
+		if _, ok := reachable[string(iter.Key()[len(prefix):])]; ok {
+			continue
+		}
+		if err = cs.st.Delete(iter.Key()); err != nil {
+			return err
+		}
+		numRemoved++
+	}
+
+	log.Infof("Removed %d state nodes of unfinished state sync", numRemoved)
+
+	return cs.st.Delete(db.TrieSyncRootKey())
+}
+
+// SaveStateSnapshot saves consecutive blocks ending at the pivot block without
+// executing them, and sets the pivot block as current block. All state nodes
+// under the state root of the pivot block, as well as the nodes on the path of
+// donation account under donationStateRoot, which is the state root of the
+// block prior to the last donation height, should have been put into store
+// before calling this. Blocks before the first saved block are not available
+// afterwards, so the ledger is not an archival ledger and history start height
+// is recorded.
+func (cs *ChainStore) SaveStateSnapshot(blocks []*block.Block, donationStateRoot Uint256) error {
+	if len(blocks) == 0 {
+		return errors.New("no blocks to save")
+	}
+
+	pivot := blocks[len(blocks)-1]
+	pivotHeight := pivot.Header.UnsignedHeader.Height
+	if pivotHeight <= cs.GetHeight() {
+		return fmt.Errorf("pivot height %d is not higher than current height %d", pivotHeight, cs.GetHeight())
+	}
+
+	root, err := Uint256ParseFromBytes(pivot.Header.UnsignedHeader.StateRoot)
+	if err != nil {
+		return err
+	}
+
+	states, err := NewStateDB(root, cs)
+	if err != nil {
+		return err
+	}
+
+	cs.st.NewBatch()
+
+	for i, b := range blocks {
+		if i > 0 {
+			prevHash, err := Uint256ParseFromBytes(b.Header.UnsignedHeader.PrevBlockHash)
+			if err != nil {
+				return err
+			}
+			if prevHash != blocks[i-1].Hash() {
+				return fmt.Errorf("block %d is not linked to previous block", b.Header.UnsignedHeader.Height)
+			}
+		}
+
+		if err := chain.TransactionsRootCheck(b); err != nil {
+			return fmt.Errorf("block %d: %v", b.Header.UnsignedHeader.Height, err)
+		}
+
+		if err := cs.batchPutBlockWithoutStates(b); err != nil {
+			return err
+		}
+	}
+
+	// blocks before the first saved block are not available for indexing
+	if config.Parameters.AddressTxIndex {
+		err = cs.st.BatchPut(db.AddressTransactionHeightKey(), encodeHeight(blocks[0].Header.UnsignedHeader.Height))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = cs.st.BatchPut(db.CurrentStateTrie(), root.ToArray())
+	if err != nil {
+		return err
+	}
+
+	donationHeight := pivotHeight / uint32(config.RewardAdjustInterval) * uint32(config.RewardAdjustInterval)
+	if donationHeight > 0 {
+		donationStates, err := NewStateDB(donationStateRoot, cs)
+		if err != nil {
+			return err
+		}
+
+		donationAddress, err := ToScriptHash(config.DonationAddress)
+		if err != nil {
+			return err
+		}
+
+		// make sure donation account is not read from a missing node
+		if _, err = donationStates.trie.TryGet(AccountStateKey(donationAddress)); err != nil {
+			return err
+		}
+
+		donation, err := calcDonation(donationStates, donationHeight)
+		if err != nil {
+			return err
+		}
+
+		w := bytes.NewBuffer(nil)
+		if err = donation.Serialize(w); err != nil {
+			return err
+		}
+
+		if err := cs.st.BatchPut(db.DonationKey(donationHeight), w.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	// states before pivot block are not available, so ref counting and
+	// pruning start from pivot block
+	heightBuffer := make([]byte, 4)
+	binary.LittleEndian.PutUint32(heightBuffer, pivotHeight-1)
+	if err = cs.st.BatchPut(db.TrieRefCountHeightKey(), heightBuffer); err != nil {
+		return err
+	}
+	if err = cs.st.BatchPut(db.TriePrunedHeightKey(), heightBuffer); err != nil {
+		return err
+	}
+
+	historyStartHeight := blocks[0].Header.UnsignedHeader.Height
+	if err = cs.st.BatchPut(db.HistoryStartHeightKey(), encodeHeight(historyStartHeight)); err != nil {
+		return err
+	}
+
+	if err = cs.st.BatchDelete(db.TrieSyncRootKey()); err != nil {
+		return err
+	}
+
+	pivotHash := pivot.Hash()
+	headerHashBuffer := bytes.NewBuffer(nil)
+	pivotHash.Serialize(headerHashBuffer)
+	serialization.WriteUint32(headerHashBuffer, pivotHeight)
+	if err = cs.st.BatchPut(db.CurrentBlockHashKey(), headerHashBuffer.Bytes()); err != nil {
+		return err
+	}
+
+	if err = cs.st.BatchCommit(); err != nil {
+		return err
+	}
+
+	cs.States = states
+
+	cs.mu.Lock()
+	cs.currentBlockHeight = pivotHeight
+	cs.currentBlockHash = pivotHash
+	cs.historyStartHeight = historyStartHeight
+	cs.mu.Unlock()
+
+	for _, b := range blocks {
+		cs.headerCache.AddHeaderToCache(b.Header)
+	}
+
+	log.Infof("State snapshot saved at height %d, state root %s, blocks before height %d are not available", pivotHeight, root.ToHexString(), historyStartHeight)
+
+	return nil
+}
+
+func (cs *ChainStore) batchPutBlockWithoutStates(b *block.Block) error {
+	headerHash := b.Hash()
+	height := b.Header.UnsignedHeader.Height
+
+	headerBuffer := bytes.NewBuffer(nil)
+	b.Trim(headerBuffer)
+	if err := cs.st.BatchPut(db.HeaderKey(headerHash), headerBuffer.Bytes()); err != nil {
+		return err
+	}
+
+	if err := cs.st.BatchPut(db.BlockhashKey(height), headerHash.ToArray()); err != nil {
+		return err
+	}
+
+	for _, txn := range b.Transactions {
+		buffer := make([]byte, 4)
+		binary.LittleEndian.PutUint32(buffer[:], height)
+		dt, err := txn.Marshal()
+		if err != nil {
+			return err
+		}
+
+		buffer = append(buffer, dt...)
+
+		if err := cs.st.BatchPut(db.TransactionKey(txn.Hash()), buffer); err != nil {
+			return err
+		}
+
+		if config.Parameters.AddressTxIndex {
+			if err := cs.batchPutAddressTxIndex(txn, height); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
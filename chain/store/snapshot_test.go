@@ -0,0 +1,153 @@
+package store
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/chain/db"
+	"github.com/nknorg/nkn/chain/trie"
+	. "github.com/nknorg/nkn/common"
+)
+
+func newTestStore(t *testing.T) (*db.LevelDBStore, func()) {
+	dir, err := ioutil.TempDir("", "chainstore")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	st, err := db.NewLevelDBStore(dir)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+
+	return st, func() {
+		st.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func commitTestTrie(t *testing.T, st *db.LevelDBStore, prefix string, n int) Uint256 {
+	tr, err := trie.New(EmptyUint256, st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < n; i++ {
+		tr.Update([]byte(fmt.Sprintf("%s%d", prefix, i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+
+	st.NewBatch()
+	root, err := tr.CommitTo(st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = st.BatchCommit(); err != nil {
+		t.Fatal(err)
+	}
+
+	return root
+}
+
+func countStateNodes(st *db.LevelDBStore) int {
+	iter := st.NewIterator(db.TrieNodePrefix())
+	defer iter.Release()
+
+	n := 0
+	for iter.Next() {
+		n++
+	}
+	return n
+}
+
+func TestCleanupStateSync(t *testing.T) {
+	st, cleanup := newTestStore(t)
+	defer cleanup()
+
+	cs := &ChainStore{st: st}
+
+	currentRoot := commitTestTrie(t, st, "current", 50)
+	if err := st.Put(db.CurrentStateTrie(), currentRoot.ToArray()); err != nil {
+		t.Fatal(err)
+	}
+	numCurrentNodes := countStateNodes(st)
+
+	// no unfinished sync, nothing should be removed
+	if err := cs.cleanupStateSync(); err != nil {
+		t.Fatal(err)
+	}
+	if n := countStateNodes(st); n != numCurrentNodes {
+		t.Fatalf("expect %d state nodes without unfinished sync, got %d", numCurrentNodes, n)
+	}
+
+	syncRoot := commitTestTrie(t, st, "synced", 200)
+	if err := cs.StartStateSync(syncRoot); err != nil {
+		t.Fatal(err)
+	}
+	if n := countStateNodes(st); n <= numCurrentNodes {
+		t.Fatalf("expect synced state nodes to be kept when sync starts, got %d nodes", n)
+	}
+
+	if err := cs.cleanupStateSync(); err != nil {
+		t.Fatal(err)
+	}
+	if n := countStateNodes(st); n != numCurrentNodes {
+		t.Fatalf("expect %d state nodes after cleanup, got %d", numCurrentNodes, n)
+	}
+	if cs.HasStateNode(syncRoot.ToArray()) {
+		t.Fatal("expect root of unfinished sync to be removed")
+	}
+	if _, err := st.Get(db.TrieSyncRootKey()); err == nil {
+		t.Fatal("expect sync root to be removed")
+	}
+
+	tr, err := trie.New(currentRoot, st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 50; i++ {
+		value, err := tr.TryGet([]byte(fmt.Sprintf("current%d", i)))
+		if err != nil || !bytes.Equal(value, []byte(fmt.Sprintf("value%d", i))) {
+			t.Fatalf("expect current states to be kept, got %s, error %v", value, err)
+		}
+	}
+}
+
+func TestGetBlockHashBeforeHistoryStart(t *testing.T) {
+	st, cleanup := newTestStore(t)
+	defer cleanup()
+
+	cs := &ChainStore{st: st, historyStartHeight: 10}
+	for _, height := range []uint32{0, 10, 11} {
+		hash := Uint256{byte(height)}
+		if err := st.Put(db.BlockhashKey(height), hash.ToArray()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		height uint32
+		err    error
+	}{
+		{"genesis block", 0, nil},
+		{"first block after genesis", 1, chain.ErrHistoryUnavailable},
+		{"block before history start", 9, chain.ErrHistoryUnavailable},
+		{"history start", 10, nil},
+		{"after history start", 11, nil},
+	}
+
+	for _, test := range tests {
+		hash, err := cs.GetBlockHash(test.height)
+		if err != test.err {
+			t.Errorf("%s: expect error %v, got %v", test.name, test.err, err)
+			continue
+		}
+		if err == nil && hash != (Uint256{byte(test.height)}) {
+			t.Errorf("%s: expect hash %v, got %v", test.name, Uint256{byte(test.height)}, hash)
+		}
+	}
+}
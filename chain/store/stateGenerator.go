@@ -25,13 +25,15 @@ func (cs *ChainStore) spendTransaction(states *StateDB, txn *transaction.Transac
 	case pb.COINBASE_TYPE:
 		coinbase := pl.(*pb.Coinbase)
 		if !genesis {
-			var donationAmount Fixed64
-			donationAmount, err = cs.GetDonation()
+			// Same as current donation when generating states of the next
+			// block, and also works for blocks already saved in ledger.
+			var donation *Donation
+			donation, err = cs.getDonationAtHeight(height - 1)
 			if err != nil {
 				return err
 			}
 
-			if err = states.UpdateBalance(BytesToUint160(coinbase.Sender), config.NKNAssetID, donationAmount, Subtraction); err != nil {
+			if err = states.UpdateBalance(BytesToUint160(coinbase.Sender), config.NKNAssetID, donation.Amount, Subtraction); err != nil {
 				return err
 			}
 
@@ -47,9 +49,28 @@ func (cs *ChainStore) spendTransaction(states *StateDB, txn *transaction.Transac
 		}
 	case pb.TRANSFER_ASSET_TYPE:
 		transfer := pl.(*pb.TransferAsset)
-		states.UpdateBalance(BytesToUint160(transfer.Sender), config.NKNAssetID, Fixed64(transfer.Amount)+Fixed64(txn.UnsignedTx.Fee), Subtraction)
+		assetID, err := chain.GetTransferAssetID(transfer)
+		if err != nil {
+			return err
+		}
+
+		if assetID == config.NKNAssetID {
+			states.UpdateBalance(BytesToUint160(transfer.Sender), config.NKNAssetID, Fixed64(transfer.Amount)+Fixed64(txn.UnsignedTx.Fee), Subtraction)
+			states.IncrNonce(BytesToUint160(transfer.Sender))
+			states.UpdateBalance(BytesToUint160(transfer.Recipient), config.NKNAssetID, Fixed64(transfer.Amount), Addition)
+			break
+		}
+
+		if err := states.UpdateBalance(BytesToUint160(transfer.Sender), config.NKNAssetID, Fixed64(txn.UnsignedTx.Fee), Subtraction); err != nil {
+			return err
+		}
+		if err := states.UpdateBalance(BytesToUint160(transfer.Sender), assetID, Fixed64(transfer.Amount), Subtraction); err != nil {
+			return err
+		}
 		states.IncrNonce(BytesToUint160(transfer.Sender))
-		states.UpdateBalance(BytesToUint160(transfer.Recipient), config.NKNAssetID, Fixed64(transfer.Amount), Addition)
+		if err := states.UpdateBalance(BytesToUint160(transfer.Recipient), assetID, Fixed64(transfer.Amount), Addition); err != nil {
+			return err
+		}
 
 	case pb.REGISTER_NAME_TYPE:
 		pg, err := txn.GetProgramHashes()
@@ -64,6 +85,19 @@ func (cs *ChainStore) spendTransaction(states *StateDB, txn *transaction.Transac
 
 		registerNamePayload := pl.(*pb.RegisterName)
 		states.setName(registerNamePayload.Registrant, registerNamePayload.Name)
+	case pb.TRANSFER_NAME_TYPE:
+		pg, err := txn.GetProgramHashes()
+		if err != nil {
+			return err
+		}
+
+		if err := states.UpdateBalance(pg[0], config.NKNAssetID, Fixed64(txn.UnsignedTx.Fee), Subtraction); err != nil {
+			return err
+		}
+		states.IncrNonce(pg[0])
+
+		transferNamePayload := pl.(*pb.TransferName)
+		states.transferName(transferNamePayload.Registrant, transferNamePayload.Recipient, transferNamePayload.Name)
 	case pb.DELETE_NAME_TYPE:
 		pg, err := txn.GetProgramHashes()
 		if err != nil {
@@ -181,6 +215,28 @@ func (cs *ChainStore) GenerateStateRoot(ctx context.Context, b *block.Block, gen
 	return root, err
 }
 
+// GenerateStateRootFromParent generates the state root of a block that has
+// been saved in ledger on top of the states of its previous block instead of
+// the current states, without committing the generated states.
+func (cs *ChainStore) GenerateStateRootFromParent(ctx context.Context, b *block.Block) (Uint256, error) {
+	stateRoot := EmptyUint256
+	height := b.Header.UnsignedHeader.Height
+	if height > 0 {
+		prevHeader, err := cs.GetHeaderByHeight(height - 1)
+		if err != nil {
+			return EmptyUint256, err
+		}
+		stateRoot, err = Uint256ParseFromBytes(prevHeader.UnsignedHeader.StateRoot)
+		if err != nil {
+			return EmptyUint256, err
+		}
+	}
+
+	_, root, err := cs.generateStateRootFrom(ctx, b, stateRoot, false)
+
+	return root, err
+}
+
 func (cs *ChainStore) generateStateRoot(ctx context.Context, b *block.Block, genesisBlockInitialized, needBeCommitted bool) (*StateDB, Uint256, error) {
 	stateRoot := EmptyUint256
 	if genesisBlockInitialized {
@@ -190,6 +246,11 @@ func (cs *ChainStore) generateStateRoot(ctx context.Context, b *block.Block, gen
 			return nil, EmptyUint256, err
 		}
 	}
+
+	return cs.generateStateRootFrom(ctx, b, stateRoot, needBeCommitted)
+}
+
+func (cs *ChainStore) generateStateRootFrom(ctx context.Context, b *block.Block, stateRoot Uint256, needBeCommitted bool) (*StateDB, Uint256, error) {
 	states, err := NewStateDB(stateRoot, cs)
 	if err != nil {
 		return nil, EmptyUint256, err
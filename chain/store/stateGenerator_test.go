@@ -0,0 +1,82 @@
+package store
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/nknorg/nkn/chain/db"
+	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/transaction"
+	"github.com/nknorg/nkn/util/config"
+)
+
+func TestSpendCoinbaseDonation(t *testing.T) {
+	st, cleanup := newTestStore(t)
+	defer cleanup()
+
+	cs := &ChainStore{st: st}
+	interval := uint32(config.RewardAdjustInterval)
+
+	// donation amount i+1 is saved at height i*interval
+	for i := uint32(0); i < 3; i++ {
+		w := bytes.NewBuffer(nil)
+		if err := NewDonation(i*interval, Fixed64(i+1)).Serialize(w); err != nil {
+			t.Fatal(err)
+		}
+		if err := st.Put(db.DonationKey(i*interval), w.Bytes()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sender := Uint160{1}
+	payload, err := transaction.Pack(pb.COINBASE_TYPE, transaction.NewCoinbase(sender, Uint160{2}, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	txn := &transaction.Transaction{Transaction: transaction.NewMsgTx(payload, 0, 0, nil)}
+
+	tests := []struct {
+		name     string
+		height   uint32
+		donation Fixed64
+	}{
+		{"first block", 1, 1},
+		{"last block of first interval", interval, 1},
+		{"first block of second interval", interval + 1, 2},
+		{"last block of second interval", 2 * interval, 2},
+		{"first block of third interval", 2*interval + 1, 3},
+	}
+
+	for _, test := range tests {
+		// live callers generate states of the block after current block, so
+		// donation at height-1 should be the same as current donation
+		cs.currentBlockHeight = test.height - 1
+		currentDonation, err := cs.GetDonation()
+		if err != nil {
+			t.Fatalf("%s: get donation error: %v", test.name, err)
+		}
+		if currentDonation != test.donation {
+			t.Errorf("%s: expect current donation %v, got %v", test.name, test.donation, currentDonation)
+		}
+
+		states, err := NewStateDB(EmptyUint256, cs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = states.SetAsset(config.NKNAssetID, "", "", 0, 0, EmptyUint160); err != nil {
+			t.Fatal(err)
+		}
+		if err = states.UpdateBalance(sender, config.NKNAssetID, 100, Addition); err != nil {
+			t.Fatal(err)
+		}
+
+		if err = cs.spendTransaction(states, txn, 0, false, test.height); err != nil {
+			t.Errorf("%s: spend transaction error: %v", test.name, err)
+			continue
+		}
+		if balance := states.GetBalance(config.NKNAssetID, sender); balance != 100-test.donation {
+			t.Errorf("%s: expect donation %v, got %v", test.name, test.donation, 100-balance)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"sync"
 
 	"github.com/nknorg/nkn/block"
+	"github.com/nknorg/nkn/chain"
 	"github.com/nknorg/nkn/chain/db"
 	. "github.com/nknorg/nkn/common"
 	"github.com/nknorg/nkn/common/serialization"
@@ -31,6 +32,7 @@ type ChainStore struct {
 
 	currentBlockHash   Uint256
 	currentBlockHeight uint32
+	historyStartHeight uint32
 }
 
 func NewLedgerStore() (*ChainStore, error) {
@@ -87,6 +89,14 @@ func (cs *ChainStore) InitLedgerStoreWithGenesisBlock(genesisBlock *block.Block)
 
 		cs.headerCache.AddHeaderToCache(currentHeader)
 
+		if heightBuffer, err := cs.st.Get(db.HistoryStartHeightKey()); err == nil && len(heightBuffer) == 4 {
+			cs.historyStartHeight = binary.LittleEndian.Uint32(heightBuffer)
+		}
+
+		if err := cs.cleanupStateSync(); err != nil {
+			return 0, err
+		}
+
 		root, err := cs.GetCurrentBlockStateRoot()
 		if err != nil {
 			return 0, nil
@@ -110,6 +120,10 @@ func (cs *ChainStore) InitLedgerStoreWithGenesisBlock(genesisBlock *block.Block)
 			}
 		}
 
+		if err := cs.initAddressTxIndex(); err != nil {
+			return 0, err
+		}
+
 		return cs.currentBlockHeight, nil
 
 	} else {
@@ -136,6 +150,10 @@ func (cs *ChainStore) InitLedgerStoreWithGenesisBlock(genesisBlock *block.Block)
 		cs.currentBlockHash = genesisBlock.Hash()
 		cs.currentBlockHeight = 0
 
+		if err := cs.initAddressTxIndex(); err != nil {
+			return 0, err
+		}
+
 		return 0, nil
 	}
 }
@@ -149,6 +167,10 @@ func (cs *ChainStore) IsTxHashDuplicate(txhash Uint256) bool {
 }
 
 func (cs *ChainStore) GetBlockHash(height uint32) (Uint256, error) {
+	if height > 0 && height < cs.GetHistoryStartHeight() {
+		return EmptyUint256, chain.ErrHistoryUnavailable
+	}
+
 	blockHash, err := cs.st.Get(db.BlockhashKey(height))
 	if err != nil {
 		return EmptyUint256, err
@@ -204,6 +226,20 @@ func (cs *ChainStore) GetTransaction(hash Uint256) (*transaction.Transaction, er
 	return t, nil
 }
 
+// GetTransactionHeight returns the height of the block containing transaction
+// hash.
+func (cs *ChainStore) GetTransactionHeight(hash Uint256) (uint32, error) {
+	value, err := cs.st.Get(db.TransactionKey(hash))
+	if err != nil {
+		return 0, err
+	}
+	if len(value) < 4 {
+		return 0, fmt.Errorf("invalid transaction data of %s", hash.ToHexString())
+	}
+
+	return binary.LittleEndian.Uint32(value), nil
+}
+
 func (cs *ChainStore) getTx(hash Uint256) (*transaction.Transaction, uint32, error) {
 	value, err := cs.st.Get(db.TransactionKey(hash))
 	if err != nil {
@@ -220,6 +256,56 @@ func (cs *ChainStore) getTx(hash Uint256) (*transaction.Transaction, uint32, err
 	return &txn, height, nil
 }
 
+// GetTransactionProof returns the header of the block containing transaction
+// hash, the index of the transaction in the block and its merkle branch to the
+// transactions root of the block.
+func (cs *ChainStore) GetTransactionProof(hash Uint256) (*block.Header, uint32, []Uint256, error) {
+	_, height, err := cs.getTx(hash)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	blockHash, err := cs.GetBlockHash(height)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	data, err := cs.st.Get(db.HeaderKey(blockHash))
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	b := new(block.Block)
+	if err = b.FromTrimmedData(bytes.NewReader(data)); err != nil {
+		return nil, 0, nil, err
+	}
+
+	index := -1
+	txnHashes := make([]Uint256, 0, len(b.Transactions))
+	for i, txn := range b.Transactions {
+		txnHash := txn.Hash()
+		if txnHash == hash {
+			index = i
+		}
+		txnHashes = append(txnHashes, txnHash)
+	}
+	if index < 0 {
+		return nil, 0, nil, fmt.Errorf("transaction %s not found in block %d", hash.ToHexString(), height)
+	}
+
+	tree, err := crypto.NewMerkleTree(txnHashes)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	branch, err := tree.GetBranch(uint32(index))
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	return b.Header, uint32(index), branch, nil
+}
+
 func (cs *ChainStore) GetBlock(hash Uint256) (*block.Block, error) {
 	bHash, err := cs.st.Get(db.HeaderKey(hash))
 	if err != nil {
@@ -296,12 +382,19 @@ func (cs *ChainStore) persist(b *block.Block) error {
 			return err
 		}
 
+		if config.Parameters.AddressTxIndex {
+			if err := cs.batchPutAddressTxIndex(txn, b.Header.UnsignedHeader.Height); err != nil {
+				return err
+			}
+		}
+
 		switch txn.UnsignedTx.Payload.Type {
 		case pb.COINBASE_TYPE:
 		case pb.SIG_CHAIN_TXN_TYPE:
 		case pb.TRANSFER_ASSET_TYPE:
 		case pb.ISSUE_ASSET_TYPE:
 		case pb.REGISTER_NAME_TYPE:
+		case pb.TRANSFER_NAME_TYPE:
 		case pb.DELETE_NAME_TYPE:
 		case pb.SUBSCRIBE_TYPE:
 		case pb.UNSUBSCRIBE_TYPE:
@@ -392,6 +485,17 @@ func (cs *ChainStore) GetCurrentBlockHash() Uint256 {
 	return cs.currentBlockHash
 }
 
+// GetHistoryStartHeight returns the height from which blocks are available in
+// local ledger. Ledger synced from a state snapshot is not an archival ledger,
+// and only has genesis block and blocks from this height. Returns 0 if all
+// blocks are available.
+func (cs *ChainStore) GetHistoryStartHeight() uint32 {
+	cs.mu.RLock()
+	defer cs.mu.RUnlock()
+
+	return cs.historyStartHeight
+}
+
 func (cs *ChainStore) GetHeight() uint32 {
 	cs.mu.RLock()
 	defer cs.mu.RUnlock()
@@ -553,7 +657,13 @@ func (cs *ChainStore) GetDonation() (Fixed64, error) {
 }
 
 func (cs *ChainStore) getDonation() (*Donation, error) {
-	currentDonationHeight := cs.currentBlockHeight / uint32(config.RewardAdjustInterval) * uint32(config.RewardAdjustInterval)
+	return cs.getDonationAtHeight(cs.currentBlockHeight)
+}
+
+// getDonationAtHeight returns the donation in effect when height is the current
+// block height.
+func (cs *ChainStore) getDonationAtHeight(height uint32) (*Donation, error) {
+	currentDonationHeight := height / uint32(config.RewardAdjustInterval) * uint32(config.RewardAdjustInterval)
 	data, err := cs.st.Get(db.DonationKey(currentDonationHeight))
 	if err != nil {
 		return nil, err
@@ -583,11 +693,16 @@ func (cs *ChainStore) CalcNextDonation(height uint32) (*Donation, error) {
 		return nil, errors.New("invalid height to update donation")
 	}
 
+	return calcDonation(cs.States, height)
+}
+
+// calcDonation calculates donation at height from states of block height-1
+func calcDonation(states *StateDB, height uint32) (*Donation, error) {
 	donationAddress, err := ToScriptHash(config.DonationAddress)
 	if err != nil {
 		return nil, err
 	}
-	account := cs.States.GetOrNewAccount(donationAddress)
+	account := states.GetOrNewAccount(donationAddress)
 	amount := account.GetBalance(config.NKNAssetID)
 	donation := amount * config.DonationAdjustDividendFactor / config.DonationAdjustDivisorFactor
 	donationPerBlock := int64(donation) / int64(config.RewardAdjustInterval)
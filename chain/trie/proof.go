@@ -0,0 +1,142 @@
+package trie
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/nknorg/nkn/common"
+)
+
+// Prove constructs a merkle proof for key. The result contains the encoded
+// nodes on the path from root to the value of key, root first. If the key is
+// not present in the trie, the proof contains the nodes of the longest
+// existing prefix of the key, which proves the absence of the key.
+func (t *Trie) Prove(key []byte) ([][]byte, error) {
+	key = keyBytesToHex(key)
+	nodes := []node{}
+	tn := t.root
+	for len(key) > 0 && tn != nil {
+		switch n := tn.(type) {
+		case *shortNode:
+			if len(key) < len(n.Key) || !bytes.Equal(n.Key, key[:len(n.Key)]) {
+				tn = nil
+			} else {
+				tn = n.Val
+				key = key[len(n.Key):]
+			}
+			nodes = append(nodes, n)
+		case *fullNode:
+			tn = n.Children[key[0]]
+			key = key[1:]
+			nodes = append(nodes, n)
+		case hashNode:
+			var err error
+			tn, err = t.resolveHash(n, false)
+			if err != nil {
+				return nil, err
+			}
+		case valueNode:
+			tn = nil
+		default:
+			panic(fmt.Sprintf("invalid node type: %v", tn))
+		}
+	}
+
+	h := newHasher()
+	defer returnHasherToPool(h)
+
+	proof := make([][]byte, 0, len(nodes))
+	for i, n := range nodes {
+		collapsed, _, err := h.hasChildren(n, nil)
+		if err != nil {
+			return nil, err
+		}
+		buf := new(bytes.Buffer)
+		if err := collapsed.Serialize(buf); err != nil {
+			return nil, err
+		}
+		// nodes smaller than 32 bytes are embedded in their parent, except root
+		if buf.Len() >= 32 || i == 0 {
+			proof = append(proof, buf.Bytes())
+		}
+	}
+
+	return proof, nil
+}
+
+// MissingNodeError is returned by VerifyProof when a node on the path of key
+// is not contained in the proof.
+type MissingNodeError struct {
+	Index int
+	Hash  []byte
+}
+
+func (e *MissingNodeError) Error() string {
+	return fmt.Sprintf("proof node %d (hash %x) missing", e.Index, e.Hash)
+}
+
+// VerifyProof checks a merkle proof generated by Prove against rootHash and
+// returns the value of key. A nil value with nil error means the proof shows
+// that key is not present in the trie.
+func VerifyProof(rootHash common.Uint256, key []byte, proof [][]byte) ([]byte, error) {
+	proofNodes := make(map[string][]byte, len(proof))
+	for _, enc := range proof {
+		proofNodes[string(hash256(enc))] = enc
+	}
+
+	key = keyBytesToHex(key)
+	wantHash := rootHash.ToArray()
+	for i := 0; ; i++ {
+		enc, ok := proofNodes[string(wantHash)]
+		if !ok {
+			return nil, &MissingNodeError{Index: i, Hash: wantHash}
+		}
+		n, err := decodeNode(wantHash, enc, false)
+		if err != nil {
+			return nil, fmt.Errorf("bad proof node %d: %v", i, err)
+		}
+		keyrest, cld := getProofChild(n, key)
+		switch cld := cld.(type) {
+		case nil:
+			return nil, nil
+		case hashNode:
+			key = keyrest
+			wantHash = cld
+		case valueNode:
+			return cld, nil
+		default:
+			return nil, errors.New("invalid proof node type")
+		}
+	}
+}
+
+func getProofChild(tn node, key []byte) ([]byte, node) {
+	for {
+		switch n := tn.(type) {
+		case *shortNode:
+			if len(key) < len(n.Key) || !bytes.Equal(n.Key, key[:len(n.Key)]) {
+				return nil, nil
+			}
+			tn = n.Val
+			key = key[len(n.Key):]
+		case *fullNode:
+			if len(key) == 0 {
+				return nil, nil
+			}
+			tn = n.Children[key[0]]
+			key = key[1:]
+		case hashNode:
+			return key, n
+		case nil:
+			return key, nil
+		case valueNode:
+			if len(key) > 0 {
+				return nil, nil
+			}
+			return nil, n
+		default:
+			panic(fmt.Sprintf("invalid node type: %v", tn))
+		}
+	}
+}
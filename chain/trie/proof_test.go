@@ -0,0 +1,48 @@
+package trie
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/nknorg/nkn/common"
+)
+
+func TestProof(t *testing.T) {
+	tr, _ := New(common.EmptyUint256, nil)
+	for i := 0; i < 500; i++ {
+		tr.TryUpdate([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	root := tr.Hash()
+
+	for i := 0; i < 500; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		proof, err := tr.Prove(key)
+		if err != nil {
+			t.Fatalf("prove key %s error: %v", key, err)
+		}
+		value, err := VerifyProof(root, key, proof)
+		if err != nil {
+			t.Fatalf("verify proof of key %s error: %v", key, err)
+		}
+		if !bytes.Equal(value, []byte(fmt.Sprintf("value%d", i))) {
+			t.Fatalf("verify proof of key %s got wrong value %s", key, value)
+		}
+	}
+
+	for _, key := range [][]byte{[]byte("key500"), []byte("ke"), []byte("nokey")} {
+		proof, err := tr.Prove(key)
+		if err != nil {
+			t.Fatalf("prove key %s error: %v", key, err)
+		}
+		value, err := VerifyProof(root, key, proof)
+		if err != nil || value != nil {
+			t.Fatalf("verify absence proof of key %s got value %s, error %v", key, value, err)
+		}
+	}
+
+	proof, _ := tr.Prove([]byte("key1"))
+	if _, err := VerifyProof(common.Uint256{}, []byte("key1"), proof); err == nil {
+		t.Fatal("verify proof against wrong root should fail")
+	}
+}
@@ -0,0 +1,54 @@
+package trie
+
+import (
+	"bytes"
+	"errors"
+)
+
+// NodeHash returns the hash of an encoded trie node, which is also the key of
+// the node in database.
+func NodeHash(enc []byte) []byte {
+	return hash256(enc)
+}
+
+// ChildrenHashes decodes an encoded trie node and returns the hashes of its
+// children that are stored as separate nodes in database. Children embedded in
+// the node are traversed as well.
+func ChildrenHashes(enc []byte) ([][]byte, error) {
+	n, err := decodeNode(nil, enc, false)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make([][]byte, 0)
+	var collect func(n node) error
+	collect = func(n node) error {
+		switch n := n.(type) {
+		case *shortNode:
+			return collect(n.Val)
+		case *fullNode:
+			for _, child := range n.Children {
+				if err := collect(child); err != nil {
+					return err
+				}
+			}
+		case hashNode:
+			hashes = append(hashes, []byte(n))
+		case valueNode, nil:
+		default:
+			return errors.New("invalid node type")
+		}
+		return nil
+	}
+
+	if err := collect(n); err != nil {
+		return nil, err
+	}
+
+	return hashes, nil
+}
+
+// VerifyNode checks if enc is the encoded trie node of hash.
+func VerifyNode(hash, enc []byte) bool {
+	return len(enc) > 0 && bytes.Equal(NodeHash(enc), hash)
+}
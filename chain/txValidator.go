@@ -20,6 +20,8 @@ var (
 	ErrIDRegistered           = errors.New("ID has be registered")
 	ErrDuplicateGenerateIDTxn = errors.New("[VerifyTransactionWithBlock], duplicate GenerateID txns")
 	ErrDuplicateIssueAssetTxn = errors.New("[VerifyTransactionWithBlock], duplicate IssueAsset txns")
+	ErrStatePruned            = errors.New("states at this height have been pruned")
+	ErrHistoryUnavailable     = errors.New("history at this height is not available in local ledger")
 )
 
 // VerifyTransaction verifys received single transaction
@@ -88,6 +90,15 @@ func checkAmountPrecise(amount Fixed64, precision byte) bool {
 	return amount.GetData()%int64(math.Pow(10, 8-float64(precision))) != 0
 }
 
+// GetTransferAssetID returns the asset ID of a transfer asset payload, which
+// is NKN if the payload does not carry an asset ID
+func GetTransferAssetID(pld *pb.TransferAsset) (Uint256, error) {
+	if len(pld.AssetId) == 0 {
+		return config.NKNAssetID, nil
+	}
+	return Uint256ParseFromBytes(pld.AssetId)
+}
+
 func verifyPubSubTopic(topic string) error {
 	match, err := regexp.MatchString("(^[A-Za-z][A-Za-z0-9-_.+]{2,254}$)", topic)
 	if err != nil {
@@ -139,6 +150,15 @@ func CheckTransactionPayload(txn *transaction.Transaction, height uint32) error
 		if pld.Amount < 0 {
 			return errors.New("transfer amount error")
 		}
+
+		if len(pld.AssetId) > 0 {
+			if ok := config.AllowTransferAssetID.GetValueAtHeight(height); !ok {
+				return errors.New("Transfer asset with asset ID is not supported yet")
+			}
+			if len(pld.AssetId) != UINT256SIZE {
+				return errors.New("length of asset ID error")
+			}
+		}
 	case pb.SIG_CHAIN_TXN_TYPE:
 	case pb.REGISTER_NAME_TYPE:
 		if ok := config.AllowTxnRegisterName.GetValueAtHeight(height); !ok {
@@ -152,6 +172,17 @@ func CheckTransactionPayload(txn *transaction.Transaction, height uint32) error
 		if !match {
 			return fmt.Errorf("name %s should start with a letter, contain A-Za-z0-9-_.+ and have length 3-255", pld.Name)
 		}
+	case pb.TRANSFER_NAME_TYPE:
+		if ok := config.AllowTxnTransferName.GetValueAtHeight(height); !ok {
+			return errors.New("Transfer name transaction is not supported yet")
+		}
+		pld := payload.(*pb.TransferName)
+		if _, err := crypto.NewPubKeyFromBytes(pld.Recipient); err != nil {
+			return fmt.Errorf("invalid recipient public key: %v", err)
+		}
+		if bytes.Equal(pld.Registrant, pld.Recipient) {
+			return errors.New("can not transfer name to registrant itself")
+		}
 	case pb.DELETE_NAME_TYPE:
 	case pb.SUBSCRIBE_TYPE:
 		pld := payload.(*pb.Subscribe)
@@ -310,7 +341,22 @@ func VerifyTransactionWithLedger(txn *transaction.Transaction) error {
 		}
 
 		pld := payload.(*pb.TransferAsset)
-		balance := DefaultLedger.Store.GetBalance(BytesToUint160(pld.Sender))
+		assetID, err := GetTransferAssetID(pld)
+		if err != nil {
+			return err
+		}
+
+		if assetID != config.NKNAssetID {
+			_, _, _, precision, err := DefaultLedger.Store.GetAsset(assetID)
+			if err != nil {
+				return fmt.Errorf("get asset %s error: %v", assetID.ToHexString(), err)
+			}
+			if checkAmountPrecise(Fixed64(pld.Amount), byte(precision)) {
+				return errors.New("the precision of amount is incorrect")
+			}
+		}
+
+		balance := DefaultLedger.Store.GetBalanceByAssetID(BytesToUint160(pld.Sender), assetID)
 		if int64(balance) < pld.Amount {
 			return errors.New("not sufficient funds")
 		}
@@ -336,6 +382,29 @@ func VerifyTransactionWithLedger(txn *transaction.Transaction) error {
 		if err != nil {
 			return err
 		}
+	case pb.TRANSFER_NAME_TYPE:
+		if err := checkNonce(); err != nil {
+			return err
+		}
+
+		pld := payload.(*pb.TransferName)
+		name, err := DefaultLedger.Store.GetName(pld.Registrant)
+		if err != nil {
+			return err
+		}
+		if name == "" {
+			return fmt.Errorf("no name registered for pubKey %+v", pld.Registrant)
+		} else if name != pld.Name {
+			return fmt.Errorf("no name %s registered for pubKey %+v", pld.Name, pld.Registrant)
+		}
+
+		name, err = DefaultLedger.Store.GetName(pld.Recipient)
+		if err != nil {
+			return err
+		}
+		if name != "" {
+			return fmt.Errorf("recipient pubKey %+v already has registered name %s", pld.Recipient, name)
+		}
 	case pb.DELETE_NAME_TYPE:
 		if err := checkNonce(); err != nil {
 			return err
@@ -457,10 +526,16 @@ type nanoPay struct {
 	nonce     uint64
 }
 
+type assetBalance struct {
+	owner   Uint160
+	assetID Uint256
+}
+
 type BlockValidationState struct {
 	sync.Mutex
 	txnlist                 map[Uint256]struct{}
 	totalAmount             map[Uint160]Fixed64
+	totalAssetAmount        map[assetBalance]Fixed64
 	registeredNames         map[string]struct{}
 	nameRegistrants         map[string]struct{}
 	generateIDs             map[string]struct{}
@@ -481,6 +556,7 @@ func NewBlockValidationState() *BlockValidationState {
 func (bvs *BlockValidationState) initBlockValidationState() {
 	bvs.txnlist = make(map[Uint256]struct{}, 0)
 	bvs.totalAmount = make(map[Uint160]Fixed64, 0)
+	bvs.totalAssetAmount = make(map[assetBalance]Fixed64, 0)
 	bvs.registeredNames = make(map[string]struct{}, 0)
 	bvs.nameRegistrants = make(map[string]struct{}, 0)
 	bvs.generateIDs = make(map[string]struct{}, 0)
@@ -493,6 +569,7 @@ func (bvs *BlockValidationState) initBlockValidationState() {
 func (bvs *BlockValidationState) Close() {
 	bvs.txnlist = nil
 	bvs.totalAmount = nil
+	bvs.totalAssetAmount = nil
 	bvs.registeredNames = nil
 	bvs.nameRegistrants = nil
 	bvs.generateIDs = nil
@@ -583,7 +660,31 @@ func (bvs *BlockValidationState) VerifyTransactionWithBlock(txn *transaction.Tra
 		}
 	case pb.TRANSFER_ASSET_TYPE:
 		transfer := payload.(*pb.TransferAsset)
-		amount = Fixed64(transfer.Amount)
+		assetID, err := GetTransferAssetID(transfer)
+		if err != nil {
+			return err
+		}
+
+		if assetID == config.NKNAssetID {
+			amount = Fixed64(transfer.Amount)
+			break
+		}
+
+		key := assetBalance{sender, assetID}
+		assetAmount := Fixed64(transfer.Amount)
+		balance := DefaultLedger.Store.GetBalanceByAssetID(sender, assetID)
+		totalAssetAmount := bvs.totalAssetAmount[key]
+		if balance < totalAssetAmount+assetAmount {
+			return errors.New("[VerifyTransactionWithBlock] not sufficient asset funds")
+		}
+
+		defer func() {
+			if e == nil {
+				bvs.addChange(func() {
+					bvs.totalAssetAmount[key] = totalAssetAmount + assetAmount
+				})
+			}
+		}()
 	case pb.REGISTER_NAME_TYPE:
 		namePayload := payload.(*pb.RegisterName)
 
@@ -605,6 +706,33 @@ func (bvs *BlockValidationState) VerifyTransactionWithBlock(txn *transaction.Tra
 				})
 			}
 		}()
+	case pb.TRANSFER_NAME_TYPE:
+		namePayload := payload.(*pb.TransferName)
+
+		name := namePayload.Name
+		if _, ok := bvs.registeredNames[name]; ok {
+			return errors.New("[VerifyTransactionWithBlock] duplicate name exist in block")
+		}
+
+		registrant := BytesToHexString(namePayload.Registrant)
+		if _, ok := bvs.nameRegistrants[registrant]; ok {
+			return errors.New("[VerifyTransactionWithBlock] duplicate registrant exist in block")
+		}
+
+		recipient := BytesToHexString(namePayload.Recipient)
+		if _, ok := bvs.nameRegistrants[recipient]; ok {
+			return errors.New("[VerifyTransactionWithBlock] duplicate recipient exist in block")
+		}
+
+		defer func() {
+			if e == nil {
+				bvs.addChange(func() {
+					bvs.registeredNames[name] = struct{}{}
+					bvs.nameRegistrants[registrant] = struct{}{}
+					bvs.nameRegistrants[recipient] = struct{}{}
+				})
+			}
+		}()
 	case pb.DELETE_NAME_TYPE:
 		namePayload := payload.(*pb.DeleteName)
 
@@ -768,7 +896,27 @@ func (bvs *BlockValidationState) CleanSubmittedTransactions(txns []*transaction.
 		switch txn.UnsignedTx.Payload.Type {
 		case pb.TRANSFER_ASSET_TYPE:
 			transfer := payload.(*pb.TransferAsset)
-			amount = Fixed64(transfer.Amount)
+			assetID, err := GetTransferAssetID(transfer)
+			if err != nil {
+				return err
+			}
+
+			if assetID == config.NKNAssetID {
+				amount = Fixed64(transfer.Amount)
+				break
+			}
+
+			key := assetBalance{sender, assetID}
+			assetAmount := Fixed64(transfer.Amount)
+			if bvs.totalAssetAmount[key] >= assetAmount {
+				bvs.totalAssetAmount[key] -= assetAmount
+
+				if bvs.totalAssetAmount[key] == 0 {
+					delete(bvs.totalAssetAmount, key)
+				}
+			} else {
+				return errors.New("[CleanSubmittedTransactions] inconsistent block validation state")
+			}
 		case pb.REGISTER_NAME_TYPE:
 			namePayload := payload.(*pb.RegisterName)
 
@@ -777,6 +925,17 @@ func (bvs *BlockValidationState) CleanSubmittedTransactions(txns []*transaction.
 
 			registrant := BytesToHexString(namePayload.Registrant)
 			delete(bvs.nameRegistrants, registrant)
+		case pb.TRANSFER_NAME_TYPE:
+			namePayload := payload.(*pb.TransferName)
+
+			name := namePayload.Name
+			delete(bvs.registeredNames, name)
+
+			registrant := BytesToHexString(namePayload.Registrant)
+			delete(bvs.nameRegistrants, registrant)
+
+			recipient := BytesToHexString(namePayload.Recipient)
+			delete(bvs.nameRegistrants, recipient)
 		case pb.DELETE_NAME_TYPE:
 			namePayload := payload.(*pb.DeleteName)
 
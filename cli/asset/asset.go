@@ -9,6 +9,7 @@ import (
 	"github.com/nknorg/nkn/api/httpjson/client"
 	. "github.com/nknorg/nkn/cli/common"
 	. "github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/transaction"
 	"github.com/nknorg/nkn/util/config"
 	"github.com/nknorg/nkn/util/password"
 	"github.com/nknorg/nkn/vault"
@@ -34,6 +35,15 @@ func parseAddress(c *cli.Context) Uint160 {
 	return EmptyUint160
 }
 
+func parseAssetID(id string) (Uint256, error) {
+	hexAssetID, err := HexStringToBytes(id)
+	if err != nil {
+		return EmptyUint256, err
+	}
+
+	return Uint256ParseFromBytes(hexAssetID)
+}
+
 func assetAction(c *cli.Context) error {
 	if c.NumFlags() == 0 {
 		cli.ShowSubcommandHelp(c)
@@ -128,7 +138,17 @@ func assetAction(c *cli.Context) error {
 			return err
 		}
 
-		txn, err := MakeTransferTransaction(myWallet, receipt, nonce, amount, txnFee)
+		var txn *transaction.Transaction
+		if id := c.String("assetid"); id != "" {
+			assetID, err := parseAssetID(id)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				return err
+			}
+			txn, err = MakeTransferAssetTransaction(myWallet, receipt, assetID, nonce, amount, txnFee)
+		} else {
+			txn, err = MakeTransferTransaction(myWallet, receipt, nonce, amount, txnFee)
+		}
 		if err != nil {
 			fmt.Fprintln(os.Stderr, err)
 			return err
@@ -209,6 +229,10 @@ func NewCommand() *cli.Command {
 				Name:  "precision",
 				Usage: "asset precision",
 			},
+			cli.StringFlag{
+				Name:  "assetid",
+				Usage: "asset ID to transfer, NKN if not specified",
+			},
 		},
 		Action: assetAction,
 		OnUsageError: func(c *cli.Context, err error, isSubcommand bool) error {
@@ -0,0 +1,152 @@
+package chaindb
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/nknorg/nkn/chain"
+	"github.com/urfave/cli"
+)
+
+const progressInterval = 10 * time.Second
+
+func exportBlocksAction(c *cli.Context) error {
+	out := c.String("out")
+	if out == "" {
+		fmt.Println("Missing --out argument")
+		return cli.NewExitError("", 1)
+	}
+
+	_, err := initLedger()
+	if err != nil {
+		return err
+	}
+	defer chain.DefaultLedger.Store.Close()
+
+	startHeight := uint32(c.Uint("from"))
+	endHeight := chain.DefaultLedger.Store.GetHeight()
+	if c.IsSet("to") {
+		endHeight = uint32(c.Uint("to"))
+	}
+
+	f, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	startTime := time.Now()
+	lastReport := startTime
+	err = chain.ExportBlocks(w, startHeight, endHeight, func(height uint32) {
+		if time.Since(lastReport) >= progressInterval {
+			fmt.Printf("Exported blocks up to height %d/%d\n", height, endHeight)
+			lastReport = time.Now()
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	err = w.Flush()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Exported %d blocks [%d, %d] to %s in %s\n", endHeight-startHeight+1, startHeight, endHeight, out, time.Since(startTime))
+
+	return nil
+}
+
+func importBlocksAction(c *cli.Context) error {
+	in := c.String("in")
+	if in == "" {
+		fmt.Println("Missing --in argument")
+		return cli.NewExitError("", 1)
+	}
+
+	f, err := os.Open(in)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = initLedger()
+	if err != nil {
+		return err
+	}
+	defer chain.DefaultLedger.Store.Close()
+
+	fmt.Printf("Importing blocks from %s, current height %d\n", in, chain.DefaultLedger.Store.GetHeight())
+
+	startTime := time.Now()
+	lastReport := startTime
+	var numImported, lastReportImported uint32
+	numImported, err = chain.ImportBlocks(bufio.NewReader(f), func(height, endHeight uint32, imported bool) {
+		if imported {
+			numImported++
+		}
+		if time.Since(lastReport) >= progressInterval {
+			rate := float64(numImported-lastReportImported) / time.Since(lastReport).Seconds()
+			fmt.Printf("Imported blocks up to height %d/%d, %.2f blocks/s\n", height, endHeight, rate)
+			lastReport = time.Now()
+			lastReportImported = numImported
+		}
+	})
+	if err != nil {
+		fmt.Printf("Import stopped at height %d, rerun the same command to resume\n", chain.DefaultLedger.Store.GetHeight())
+		return err
+	}
+
+	fmt.Printf("Imported %d blocks in %s, current height %d\n", numImported, time.Since(startTime), chain.DefaultLedger.Store.GetHeight())
+
+	return nil
+}
+
+func NewExportBlocksCommand() *cli.Command {
+	return &cli.Command{
+		Name:        "export-blocks",
+		Usage:       "export blocks in local ledger to a file",
+		Description: "Export blocks in local ledger to a file that can be imported by import-blocks. Node should be stopped.",
+		ArgsUsage:   "[args]",
+		Flags: []cli.Flag{
+			cli.UintFlag{
+				Name:  "from",
+				Usage: "start height of exported blocks",
+			},
+			cli.UintFlag{
+				Name:  "to",
+				Usage: "end height of exported blocks (default: current height)",
+			},
+			cli.StringFlag{
+				Name:  "out",
+				Usage: "output file",
+			},
+		},
+		Action: exportBlocksAction,
+		OnUsageError: func(c *cli.Context, err error, isSubcommand bool) error {
+			return cli.NewExitError("", 1)
+		},
+	}
+}
+
+func NewImportBlocksCommand() *cli.Command {
+	return &cli.Command{
+		Name:        "import-blocks",
+		Usage:       "import blocks from a file to local ledger",
+		Description: "Import blocks exported by export-blocks to local ledger with full validation. Blocks already in local ledger are skipped, so an interrupted import can be resumed. Node should be stopped.",
+		ArgsUsage:   "[args]",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "in",
+				Usage: "input file",
+			},
+		},
+		Action: importBlocksAction,
+		OnUsageError: func(c *cli.Context, err error, isSubcommand bool) error {
+			return cli.NewExitError("", 1)
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package chaindb
+
+import (
+	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/chain/store"
+	"github.com/nknorg/nkn/por"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/util/log"
+)
+
+// initLedger loads config and opens the local ledger for offline operations.
+// Node should be stopped as the chain db can only be opened by one process.
+func initLedger() (*store.ChainStore, error) {
+	config.SkipNAT = true
+	err := config.Init()
+	if err != nil {
+		return nil, err
+	}
+
+	err = log.Init()
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := store.NewLedgerStore()
+	if err != nil {
+		return nil, err
+	}
+
+	blockChain, err := chain.NewBlockchainWithGenesisBlock(store)
+	if err != nil {
+		store.Close()
+		return nil, err
+	}
+
+	chain.DefaultLedger = &chain.Ledger{
+		Blockchain: blockChain,
+		Store:      store,
+	}
+	por.Store = chain.DefaultLedger.Store
+
+	return store, nil
+}
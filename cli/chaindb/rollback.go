@@ -0,0 +1,86 @@
+package chaindb
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nknorg/nkn/block"
+	"github.com/urfave/cli"
+)
+
+func rollbackAction(c *cli.Context) error {
+	if !c.IsSet("height") {
+		fmt.Println("Missing --height argument")
+		return cli.NewExitError("", 1)
+	}
+	height := uint32(c.Uint("height"))
+
+	cs, err := initLedger()
+	if err != nil {
+		return err
+	}
+	defer cs.Close()
+
+	currentHeight := cs.GetHeight()
+	refCountStartHeight, pruningStartHeight := cs.GetPruningStartHeight()
+	fmt.Printf("Current height %d, ref count start height %d, pruning start height %d\n", currentHeight, refCountStartHeight, pruningStartHeight)
+
+	err = cs.CheckRollbackToHeight(height)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("dry-run") {
+		for h := currentHeight; h > height; h-- {
+			b, err := cs.GetBlockByHeight(h)
+			if err != nil {
+				return fmt.Errorf("get block at height %d error: %v", h, err)
+			}
+			blockHash := b.Hash()
+			fmt.Printf("Would rollback block %d %s with %d txns\n", h, blockHash.ToHexString(), len(b.Transactions))
+		}
+		fmt.Printf("Dry run: would rollback %d blocks from height %d to %d\n", currentHeight-height, currentHeight, height)
+		return nil
+	}
+
+	startTime := time.Now()
+	lastReport := startTime
+	err = cs.RollbackToHeight(height, func(b *block.Block) {
+		if time.Since(lastReport) >= progressInterval {
+			fmt.Printf("Rolled back to height %d, target height %d\n", b.Header.UnsignedHeader.Height-1, height)
+			lastReport = time.Now()
+		}
+	})
+	if err != nil {
+		fmt.Printf("Rollback stopped at height %d\n", cs.GetHeight())
+		return err
+	}
+
+	currentHash := cs.GetCurrentBlockHash()
+	fmt.Printf("Rolled back %d blocks in %s, current height %d, current block hash %s\n", currentHeight-height, time.Since(startTime), cs.GetHeight(), currentHash.ToHexString())
+
+	return nil
+}
+
+func NewRollbackCommand() *cli.Command {
+	return &cli.Command{
+		Name:        "rollback",
+		Usage:       "rollback local ledger to a given height",
+		Description: "Rollback blocks in local ledger until a given height. States at the given height should not have been pruned. Node should be stopped.",
+		ArgsUsage:   "[args]",
+		Flags: []cli.Flag{
+			cli.UintFlag{
+				Name:  "height",
+				Usage: "block height to rollback to",
+			},
+			cli.BoolFlag{
+				Name:  "dry-run",
+				Usage: "only check and print blocks to be rolled back without changing ledger",
+			},
+		},
+		Action: rollbackAction,
+		OnUsageError: func(c *cli.Context, err error, isSubcommand bool) error {
+			return cli.NewExitError("", 1)
+		},
+	}
+}
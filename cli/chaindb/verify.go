@@ -0,0 +1,139 @@
+package chaindb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/urfave/cli"
+)
+
+type verifyChainReport struct {
+	StartHeight          uint32                    `json:"startHeight"`
+	EndHeight            uint32                    `json:"endHeight"`
+	VerifiedHeight       uint32                    `json:"verifiedHeight"`
+	NumBlocks            uint32                    `json:"numBlocks"`
+	NumStateRootsChecked uint32                    `json:"numStateRootsChecked"`
+	FirstDivergentHeight *uint32                   `json:"firstDivergentHeight"`
+	Errors               []*chain.BlockVerifyError `json:"errors"`
+}
+
+func verifyChainAction(c *cli.Context) error {
+	cs, err := initLedger()
+	if err != nil {
+		return err
+	}
+	defer cs.Close()
+
+	report := &verifyChainReport{
+		StartHeight: uint32(c.Uint("from")),
+		EndHeight:   chain.DefaultLedger.Store.GetHeight(),
+		Errors:      make([]*chain.BlockVerifyError, 0),
+	}
+	if c.IsSet("to") {
+		report.EndHeight = uint32(c.Uint("to"))
+	}
+	if report.StartHeight > report.EndHeight {
+		return fmt.Errorf("start height %d is higher than end height %d", report.StartHeight, report.EndHeight)
+	}
+
+	// states of blocks before pruning start height are no longer available
+	_, pruningStartHeight := cs.GetPruningStartHeight()
+	skipStateRoot := c.Bool("skip-state-root")
+	jsonOutput := c.Bool("json")
+
+	lastReport := time.Now()
+	for height := report.StartHeight; height <= report.EndHeight; height++ {
+		checkStateRoot := !skipStateRoot && (height == 0 || pruningStartHeight == 0 || height-1 >= pruningStartHeight)
+		if verifyErr := chain.VerifyStoredBlock(context.Background(), height, checkStateRoot); verifyErr != nil {
+			report.Errors = append(report.Errors, verifyErr)
+			if report.FirstDivergentHeight == nil {
+				h := height
+				report.FirstDivergentHeight = &h
+			}
+			if !jsonOutput {
+				fmt.Println(verifyErr.Error())
+			}
+			if c.Bool("stop-on-error") {
+				break
+			}
+		}
+
+		report.VerifiedHeight = height
+		report.NumBlocks++
+		if checkStateRoot {
+			report.NumStateRootsChecked++
+		}
+
+		if !jsonOutput && time.Since(lastReport) >= progressInterval {
+			fmt.Printf("Verified blocks up to height %d/%d\n", height, report.EndHeight)
+			lastReport = time.Now()
+		}
+
+		if height == report.EndHeight {
+			break
+		}
+	}
+
+	if jsonOutput {
+		b, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+	} else {
+		fmt.Printf("Verified %d blocks [%d, %d], %d state roots checked, %d errors\n", report.NumBlocks, report.StartHeight, report.VerifiedHeight, report.NumStateRootsChecked, len(report.Errors))
+		if report.FirstDivergentHeight != nil {
+			fmt.Printf("First divergent height: %d\n", *report.FirstDivergentHeight)
+		}
+	}
+
+	if len(report.Errors) > 0 {
+		return cli.NewExitError("", 1)
+	}
+
+	return nil
+}
+
+func NewVerifyChainCommand() *cli.Command {
+	return &cli.Command{
+		Name:        "verify-chain",
+		Usage:       "verify consistency of local chain db",
+		Description: "Verify block hash, header linkage, signature and state root of blocks in local chain db. Node should be stopped.",
+		ArgsUsage:   "[args]",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:        "chaindb",
+				Usage:       "directory where blockchain data is stored",
+				Destination: &config.ChainDBPath,
+			},
+			cli.UintFlag{
+				Name:  "from",
+				Usage: "start height of verification",
+			},
+			cli.UintFlag{
+				Name:  "to",
+				Usage: "end height of verification (default: current height)",
+			},
+			cli.BoolFlag{
+				Name:  "stop-on-error",
+				Usage: "stop at the first block that fails verification",
+			},
+			cli.BoolFlag{
+				Name:  "skip-state-root",
+				Usage: "skip recomputing state root, which is slow",
+			},
+			cli.BoolFlag{
+				Name:  "json",
+				Usage: "print report in json format",
+			},
+		},
+		Action: verifyChainAction,
+		OnUsageError: func(c *cli.Context, err error, isSubcommand bool) error {
+			return cli.NewExitError("", 1)
+		},
+	}
+}
@@ -28,6 +28,8 @@ func infoAction(c *cli.Context) (err error) {
 	balance := c.String("balance")
 	nonce := c.String("nonce")
 	id := c.String("id")
+	history := c.String("history")
+	estimatefee := c.Bool("estimatefee")
 	pretty := c.Bool("pretty")
 
 	var resp []byte
@@ -171,6 +173,42 @@ func infoAction(c *cli.Context) (err error) {
 		output = append(output, resp)
 	}
 
+	if history != "" {
+		resp, err := client.Call(Address(), "gettransactionsbyaddr", 0, map[string]interface{}{
+			"address": history,
+			"offset":  c.Uint("offset"),
+			"limit":   c.Uint("limit"),
+		})
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return err
+		}
+		if pretty {
+			if p, err := PrettyPrinter(resp).PrettyTxnList(); err == nil {
+				resp = p // replace resp if pretty success
+			} else {
+				fmt.Fprintln(os.Stderr, "Output origin resp due to PrettyPrint fail: ", err)
+			}
+		}
+		output = append(output, resp)
+	}
+
+	if estimatefee {
+		params := map[string]interface{}{}
+		if c.IsSet("blocks") {
+			params["blocks"] = c.Uint("blocks")
+		}
+		if c.IsSet("size") {
+			params["size"] = c.Uint("size")
+		}
+		resp, err := client.Call(Address(), "estimatefee", 0, params)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return err
+		}
+		output = append(output, resp)
+	}
+
 	for _, v := range output {
 		FormatOutput(v)
 	}
@@ -242,6 +280,31 @@ func NewCommand() *cli.Command {
 				Name:  "id",
 				Usage: "id from publickey",
 			},
+			cli.StringFlag{
+				Name:  "history",
+				Usage: "transaction history of a address, newest first",
+			},
+			cli.UintFlag{
+				Name:  "offset",
+				Usage: "offset for querying transaction history",
+			},
+			cli.UintFlag{
+				Name:  "limit",
+				Usage: "limit for querying transaction history",
+				Value: 100,
+			},
+			cli.BoolFlag{
+				Name:  "estimatefee",
+				Usage: "suggested transaction fee for several confirmation targets",
+			},
+			cli.UintFlag{
+				Name:  "blocks",
+				Usage: "number of recent blocks used for fee estimation",
+			},
+			cli.UintFlag{
+				Name:  "size",
+				Usage: "transaction size in bytes for fee estimation",
+			},
 		},
 		Action: infoAction,
 		OnUsageError: func(c *cli.Context, err error, isSubcommand bool) error {
@@ -64,7 +64,10 @@ func TxnUnmarshal(m map[string]interface{}) (interface{}, error) {
 			m["payloadData"] = pay.ToMap()
 		}
 	case pb.PayloadType_name[int32(pb.TRANSFER_NAME_TYPE)]:
-		fallthrough //TODO
+		transName := &pb.TransferName{}
+		if err = transName.Unmarshal(buf); err == nil { // bin to pb struct of TransferName txn
+			m["payloadData"] = transName.ToMap()
+		}
 	case pb.PayloadType_name[int32(pb.DELETE_NAME_TYPE)]:
 		fallthrough //TODO
 	case pb.PayloadType_name[int32(pb.ISSUE_ASSET_TYPE)]:
@@ -95,6 +98,31 @@ func (resp PrettyPrinter) PrettyTxn() ([]byte, error) {
 	return json.Marshal(m)
 }
 
+func (resp PrettyPrinter) PrettyTxnList() ([]byte, error) {
+	m := map[string]interface{}{}
+	err := json.Unmarshal(resp, &m)
+	if err != nil {
+		return nil, err
+	}
+
+	v, ok := m["result"]
+	if !ok {
+		return nil, fmt.Errorf("response No such key [result]")
+	}
+
+	lst := make([]interface{}, 0)
+	for _, t := range v.([]interface{}) {
+		if m, err := TxnUnmarshal(t.(map[string]interface{})); err == nil {
+			lst = append(lst, m)
+		} else {
+			lst = append(lst, t) // append origin txn if TxnUnmarshal fail
+		}
+	}
+	m["result"] = lst
+
+	return json.Marshal(m)
+}
+
 func (resp PrettyPrinter) PrettyBlock() ([]byte, error) {
 	m := map[string]interface{}{}
 	err := json.Unmarshal(resp, &m)
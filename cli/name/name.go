@@ -50,6 +50,26 @@ func nameAction(c *cli.Context) error {
 		txn, _ := MakeRegisterNameTransaction(myWallet, name, nonce, txnFee)
 		buff, _ := txn.Marshal()
 		resp, err = client.Call(Address(), "sendrawtransaction", 0, map[string]interface{}{"tx": hex.EncodeToString(buff)})
+	case c.Bool("transfer"):
+		name := c.String("name")
+		if name == "" {
+			fmt.Println("name is required with [--name]")
+			return nil
+		}
+		to := c.String("to")
+		if to == "" {
+			fmt.Println("recipient public key is required with [--to]")
+			return nil
+		}
+		recipient, err := HexStringToBytes(to)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return err
+		}
+
+		txn, _ := MakeTransferNameTransaction(myWallet, name, recipient, nonce, txnFee)
+		buff, _ := txn.Marshal()
+		resp, err = client.Call(Address(), "sendrawtransaction", 0, map[string]interface{}{"tx": hex.EncodeToString(buff)})
 	case c.Bool("del"):
 		name := c.String("name")
 		if name == "" {
@@ -84,6 +104,10 @@ func NewCommand() *cli.Command {
 				Name:  "reg, r",
 				Usage: "register name for your address",
 			},
+			cli.BoolFlag{
+				Name:  "transfer, t",
+				Usage: "transfer name of your address to another public key",
+			},
 			cli.BoolFlag{
 				Name:  "del, d",
 				Usage: "delete name of your address",
@@ -92,6 +116,10 @@ func NewCommand() *cli.Command {
 				Name:  "name",
 				Usage: "name",
 			},
+			cli.StringFlag{
+				Name:  "to",
+				Usage: "recipient public key (hex) for name transfer",
+			},
 			cli.StringFlag{
 				Name:  "wallet, w",
 				Usage: "wallet name",
@@ -98,6 +98,46 @@ func levelUp(nodes []*MerkleTreeNode) []*MerkleTreeNode {
 	return nextLevel
 }
 
+//GetBranch returns the sibling hashes from the leaf at index up to the root,
+//which can be verified by VerifyBranch
+func (t *MerkleTree) GetBranch(index uint32) ([]Uint256, error) {
+	if t.Depth == 0 || uint64(index)>>(t.Depth-1) != 0 {
+		return nil, errors.New("GetBranch index out of range.")
+	}
+
+	branch := make([]Uint256, t.Depth-1)
+	node := t.Root
+	for level := t.Depth - 1; level > 0; level-- {
+		if (index>>(level-1))&1 == 0 {
+			branch[level-1] = node.Right.Hash
+			node = node.Left
+		} else {
+			// right child is a duplicate of left one, which means no such leaf
+			if node.Left == node.Right {
+				return nil, errors.New("GetBranch index out of range.")
+			}
+			branch[level-1] = node.Left.Hash
+			node = node.Right
+		}
+	}
+
+	return branch, nil
+}
+
+//VerifyBranch checks if leaf at index is included in the MerkleTree of root
+func VerifyBranch(leaf Uint256, index uint32, branch []Uint256, root Uint256) bool {
+	hash := leaf
+	for _, sibling := range branch {
+		if index&1 == 0 {
+			hash = DOUBLE_SHA256([]Uint256{hash, sibling})
+		} else {
+			hash = DOUBLE_SHA256([]Uint256{sibling, hash})
+		}
+		index >>= 1
+	}
+	return index == 0 && hash == root
+}
+
 //input a []uint256, create a MerkleTree & calc the root hash
 func ComputeRoot(hashes []Uint256) (Uint256, error) {
 	if len(hashes) == 0 {
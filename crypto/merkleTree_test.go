@@ -25,3 +25,30 @@ func TestHash(t *testing.T) {
 	fmt.Printf("[Root Hash]:%x\n", x)
 
 }
+
+func TestBranch(t *testing.T) {
+	for n := 1; n <= 17; n++ {
+		var data []Uint256
+		for i := 0; i < n; i++ {
+			data = append(data, Uint256(sha256.Sum256([]byte{byte(i)})))
+		}
+		root, _ := ComputeRoot(data)
+		tree, _ := NewMerkleTree(data)
+		for i := 0; i < n; i++ {
+			branch, err := tree.GetBranch(uint32(i))
+			if err != nil {
+				t.Fatalf("GetBranch(%d) of %d leaves error: %v", i, n, err)
+			}
+			if !VerifyBranch(data[i], uint32(i), branch, root) {
+				t.Fatalf("VerifyBranch(%d) of %d leaves failed", i, n)
+			}
+			// the last odd leaf is paired with itself, so its position is ambiguous
+			if n > 1 && branch[0] != data[i] && VerifyBranch(data[i], uint32(i)^1, branch, root) {
+				t.Fatalf("VerifyBranch(%d) of %d leaves with wrong index passed", i, n)
+			}
+		}
+		if _, err := tree.GetBranch(uint32(n)); err == nil {
+			t.Fatalf("GetBranch(%d) of %d leaves should fail", n, n)
+		}
+	}
+}
@@ -9,4 +9,10 @@ const (
 	NewBlockProduced
 	SendInboundMessageToClient
 	BacktrackSigChain
+	SendDeliveryAckToClient
+	// NewTransactionAdded is called when a transaction is admitted to txpool
+	NewTransactionAdded
+	// TransactionReplaced is called when a transaction in txpool is replaced by
+	// another one with the same nonce and higher fee
+	TransactionReplaced
 )
@@ -6,6 +6,7 @@ import (
 
 	_ "github.com/nknorg/nkn/cli"
 	"github.com/nknorg/nkn/cli/asset"
+	"github.com/nknorg/nkn/cli/chaindb"
 	. "github.com/nknorg/nkn/cli/common"
 	"github.com/nknorg/nkn/cli/debug"
 	"github.com/nknorg/nkn/cli/id"
@@ -49,6 +50,7 @@ func main() {
 		*pubsub.NewCommand(),
 		*id.NewCommand(),
 		*pruning.NewCommand(),
+		*chaindb.NewVerifyChainCommand(),
 	}
 	sort.Sort(cli.CommandsByName(app.Commands))
 	sort.Sort(cli.FlagsByName(app.Flags))
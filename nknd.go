@@ -22,6 +22,7 @@ import (
 	"github.com/nknorg/nkn/api/websocket"
 	"github.com/nknorg/nkn/chain"
 	"github.com/nknorg/nkn/chain/store"
+	"github.com/nknorg/nkn/cli/chaindb"
 	. "github.com/nknorg/nkn/common"
 	"github.com/nknorg/nkn/consensus"
 	"github.com/nknorg/nkn/crypto"
@@ -277,7 +278,10 @@ func nknMain(c *cli.Context) error {
 	rpcServer := httpjson.NewServer(localNode, wallet)
 
 	// start websocket server
-	ws := websocket.NewServer(localNode, wallet)
+	ws, err := websocket.NewServer(localNode, wallet)
+	if err != nil {
+		return err
+	}
 
 	nn.MustApplyMiddleware(chord.SuccessorAdded{func(remoteNode *nnetnode.RemoteNode, index int) bool {
 		if index == 0 {
@@ -482,6 +486,11 @@ func main() {
 		},
 	}
 	app.Action = nknMain
+	app.Commands = []cli.Command{
+		*chaindb.NewExportBlocksCommand(),
+		*chaindb.NewImportBlocksCommand(),
+		*chaindb.NewRollbackCommand(),
+	}
 
 	// app.Run will shutdown graceful.
 	if err := app.Run(os.Args); err != nil {
@@ -0,0 +1,231 @@
+package node
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/util/address"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/util/log"
+)
+
+var (
+	ErrClientDenied = errors.New("client address is denied by access list")
+	ErrDestDenied   = errors.New("destination address is denied by access list")
+)
+
+// AccessRule matches a client address if all non-empty fields match. Name is
+// resolved to public key of its registrant when access list is loaded.
+type AccessRule struct {
+	PubKey           string `json:"pubKey,omitempty"`
+	IdentifierPrefix string `json:"identifierPrefix,omitempty"`
+	Name             string `json:"name,omitempty"`
+
+	pubKey []byte
+}
+
+// AccessRules rejects an address if it matches any deny rule, or allow rules
+// are not empty and it matches none of them.
+type AccessRules struct {
+	Allow []*AccessRule `json:"allow"`
+	Deny  []*AccessRule `json:"deny"`
+}
+
+// AccessListConfig is the format of access list file
+type AccessListConfig struct {
+	Client AccessRules `json:"client"`
+	Dest   AccessRules `json:"dest"`
+}
+
+// AccessListStats is the statistics of access list
+type AccessListStats struct {
+	NumClientRules     int    `json:"numClientRules"`
+	NumDestRules       int    `json:"numDestRules"`
+	NumRejectedClients uint64 `json:"numRejectedClients"`
+	NumRejectedDests   uint64 `json:"numRejectedDests"`
+}
+
+type accessList struct {
+	sync.RWMutex
+	config             *AccessListConfig
+	numRejectedClients uint64
+	numRejectedDests   uint64
+}
+
+func (rule *AccessRule) init() error {
+	if len(rule.PubKey) == 0 && len(rule.IdentifierPrefix) == 0 && len(rule.Name) == 0 {
+		return errors.New("access rule should have at least one field")
+	}
+
+	if len(rule.PubKey) > 0 {
+		pubKey, err := hex.DecodeString(rule.PubKey)
+		if err != nil {
+			return fmt.Errorf("decode pubKey %s error: %v", rule.PubKey, err)
+		}
+		rule.pubKey = pubKey
+	}
+
+	if len(rule.Name) > 0 {
+		registrant, err := chain.DefaultLedger.Store.GetRegistrant(rule.Name)
+		if err != nil {
+			return fmt.Errorf("get registrant of name %s error: %v", rule.Name, err)
+		}
+		if len(registrant) == 0 {
+			log.Warningf("Name %s in access list is not registered", rule.Name)
+		}
+		if rule.pubKey != nil && !bytes.Equal(rule.pubKey, registrant) {
+			log.Warningf("Name %s in access list is not registered by %s", rule.Name, rule.PubKey)
+		}
+		rule.pubKey = registrant
+	}
+
+	return nil
+}
+
+func (rule *AccessRule) match(pubKey []byte, identifier string) bool {
+	if (len(rule.PubKey) > 0 || len(rule.Name) > 0) && !bytes.Equal(rule.pubKey, pubKey) {
+		return false
+	}
+	if len(rule.IdentifierPrefix) > 0 && !strings.HasPrefix(identifier, rule.IdentifierPrefix) {
+		return false
+	}
+	return true
+}
+
+func (rules *AccessRules) init() error {
+	for _, rule := range rules.Allow {
+		if err := rule.init(); err != nil {
+			return err
+		}
+	}
+	for _, rule := range rules.Deny {
+		if err := rule.init(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rules *AccessRules) isAllowed(addrStr string) bool {
+	if len(rules.Allow) == 0 && len(rules.Deny) == 0 {
+		return true
+	}
+
+	_, pubKey, identifier, err := address.ParseClientAddress(addrStr)
+	if err != nil {
+		return false
+	}
+
+	for _, rule := range rules.Deny {
+		if rule.match(pubKey, identifier) {
+			return false
+		}
+	}
+
+	if len(rules.Allow) == 0 {
+		return true
+	}
+
+	for _, rule := range rules.Allow {
+		if rule.match(pubKey, identifier) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func loadAccessListConfig(file string) (*AccessListConfig, error) {
+	alc := &AccessListConfig{}
+	if len(file) == 0 {
+		return alc, nil
+	}
+
+	buf, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove the UTF-8 Byte Order Mark
+	buf = bytes.TrimPrefix(buf, []byte("\xef\xbb\xbf"))
+
+	err = json.Unmarshal(buf, alc)
+	if err != nil {
+		return nil, err
+	}
+
+	err = alc.Client.init()
+	if err != nil {
+		return nil, err
+	}
+
+	err = alc.Dest.init()
+	if err != nil {
+		return nil, err
+	}
+
+	return alc, nil
+}
+
+// ReloadAccessList loads access list from AccessListFile in config and
+// replaces the current one. Current one is kept if loading fails.
+func (localNode *LocalNode) ReloadAccessList() error {
+	alc, err := loadAccessListConfig(config.Parameters.AccessListFile)
+	if err != nil {
+		return err
+	}
+
+	localNode.accessList.Lock()
+	localNode.accessList.config = alc
+	localNode.accessList.Unlock()
+
+	log.Infof("Loaded access list with %d client rules and %d dest rules", len(alc.Client.Allow)+len(alc.Client.Deny), len(alc.Dest.Allow)+len(alc.Dest.Deny))
+
+	return nil
+}
+
+// CheckClientAccess returns ErrClientDenied if client address should not be
+// served by local node.
+func (localNode *LocalNode) CheckClientAccess(addrStr string) error {
+	localNode.accessList.Lock()
+	defer localNode.accessList.Unlock()
+	if localNode.accessList.config != nil && !localNode.accessList.config.Client.isAllowed(addrStr) {
+		localNode.accessList.numRejectedClients++
+		return ErrClientDenied
+	}
+	return nil
+}
+
+// CheckDestAccess returns ErrDestDenied if messages should not be relayed to
+// destination address.
+func (localNode *LocalNode) CheckDestAccess(addrStr string) error {
+	localNode.accessList.Lock()
+	defer localNode.accessList.Unlock()
+	if localNode.accessList.config != nil && !localNode.accessList.config.Dest.isAllowed(addrStr) {
+		localNode.accessList.numRejectedDests++
+		return ErrDestDenied
+	}
+	return nil
+}
+
+// GetAccessListStats returns the statistics of access list
+func (localNode *LocalNode) GetAccessListStats() *AccessListStats {
+	localNode.accessList.RLock()
+	defer localNode.accessList.RUnlock()
+	stats := &AccessListStats{
+		NumRejectedClients: localNode.accessList.numRejectedClients,
+		NumRejectedDests:   localNode.accessList.numRejectedDests,
+	}
+	if alc := localNode.accessList.config; alc != nil {
+		stats.NumClientRules = len(alc.Client.Allow) + len(alc.Client.Deny)
+		stats.NumDestRules = len(alc.Dest.Allow) + len(alc.Dest.Deny)
+	}
+	return stats
+}
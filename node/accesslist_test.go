@@ -0,0 +1,52 @@
+package node
+
+import (
+	"testing"
+)
+
+func newTestAccessRule(t *testing.T, pubKey, identifierPrefix string) *AccessRule {
+	rule := &AccessRule{PubKey: pubKey, IdentifierPrefix: identifierPrefix}
+	if err := rule.init(); err != nil {
+		t.Fatal(err)
+	}
+	return rule
+}
+
+func TestAccessRulesIsAllowed(t *testing.T) {
+	allowAll := &AccessRules{}
+	denyOnly := &AccessRules{
+		Deny: []*AccessRule{newTestAccessRule(t, "01", ""), newTestAccessRule(t, "", "bot")},
+	}
+	allowOnly := &AccessRules{
+		Allow: []*AccessRule{newTestAccessRule(t, "02", "app")},
+	}
+	allowAndDeny := &AccessRules{
+		Allow: []*AccessRule{newTestAccessRule(t, "02", "")},
+		Deny:  []*AccessRule{newTestAccessRule(t, "02", "bot")},
+	}
+
+	tests := []struct {
+		name    string
+		rules   *AccessRules
+		addr    string
+		allowed bool
+	}{
+		{"no rules", allowAll, "any.01", true},
+		{"deny by pubkey", denyOnly, "app.01", false},
+		{"deny by pubkey without identifier", denyOnly, "01", false},
+		{"deny by identifier prefix", denyOnly, "bot1.02", false},
+		{"not denied", denyOnly, "app.02", true},
+		{"allow by pubkey and identifier prefix", allowOnly, "app1.02", true},
+		{"identifier prefix not allowed", allowOnly, "bot.02", false},
+		{"pubkey not allowed", allowOnly, "app.03", false},
+		{"deny overrides allow", allowAndDeny, "bot.02", false},
+		{"allowed and not denied", allowAndDeny, "app.02", true},
+		{"not allowed", allowAndDeny, "app.03", false},
+	}
+
+	for _, test := range tests {
+		if allowed := test.rules.isAllowed(test.addr); allowed != test.allowed {
+			t.Errorf("%s: expect allowed %v, got %v", test.name, test.allowed, allowed)
+		}
+	}
+}
@@ -33,6 +33,7 @@ type LocalNode struct {
 	account            *vault.Account // local node wallet account
 	nnet               *nnet.NNet     // nnet instance
 	relayer            *RelayService  // relay service
+	accessList         accessList     // client and destination access list
 	quit               chan bool      // block syncing channel
 	requestSigChainTxn *requestTxn
 	receiveTxnMsg      *receiveTxnMsg
@@ -176,6 +177,11 @@ func NewLocalNode(wallet vault.Wallet, nn *nnet.NNet) (*LocalNode, error) {
 }
 
 func (localNode *LocalNode) Start() error {
+	err := localNode.ReloadAccessList()
+	if err != nil {
+		return err
+	}
+
 	localNode.startRelayer()
 	localNode.initSyncing()
 	localNode.initTxnHandlers()
@@ -303,10 +309,28 @@ func (localNode *LocalNode) SetSyncState(s pb.SyncState) bool {
 	changed := localNode.Node.SetSyncState(s)
 	if changed && s == pb.PERSIST_FINISHED {
 		localNode.verifyNeighbors()
+		go localNode.restoreTxnPool()
 	}
 	return changed
 }
 
+// restoreTxnPool loads txns in txpool journal after local ledger is up to
+// date, and broadcasts restored txns to the network.
+func (localNode *LocalNode) restoreTxnPool() {
+	txns, err := localNode.TxnPool.LoadJournal()
+	if err != nil {
+		log.Warningf("Load txpool journal error: %v", err)
+		return
+	}
+
+	for _, txn := range txns {
+		err = localNode.BroadcastTransaction(txn)
+		if err != nil {
+			log.Warningf("Broadcast restored txn error: %v", err)
+		}
+	}
+}
+
 func (localNode *LocalNode) verifyNeighbors() {
 	for _, nbr := range localNode.GetNeighbors(nil) {
 		err := localNode.verifyRemoteNode(nbr.nnetNode)
@@ -167,6 +167,7 @@ func (localNode *LocalNode) remoteMessageRouted(remoteMessage *nnetnode.RemoteMe
 
 			if len(remoteNodes) > 1 {
 				log.Errorf("multiple next hop is not supported for relay message")
+				localNode.TraceRelayMessage(relayMessage, pb.TRACE_DROPPED, "multiple next hop")
 				return nil, nil, nil, false
 			}
 
@@ -175,6 +176,7 @@ func (localNode *LocalNode) remoteMessageRouted(remoteMessage *nnetnode.RemoteMe
 				nextHop = localNode.getNbrByNNetNode(remoteNodes[0])
 				if nextHop == nil {
 					log.Errorf("cannot get next hop neighbor node")
+					localNode.TraceRelayMessage(relayMessage, pb.TRACE_DROPPED, "next hop is not neighbor")
 					return nil, nil, nil, false
 				}
 			}
@@ -182,9 +184,14 @@ func (localNode *LocalNode) remoteMessageRouted(remoteMessage *nnetnode.RemoteMe
 			err = localNode.relayer.signRelayMessage(relayMessage, nextHop, senderRemoteNode)
 			if err != nil {
 				log.Errorf("sign relay message error: %v", err)
+				localNode.TraceRelayMessage(relayMessage, pb.TRACE_DROPPED, err.Error())
 				return nil, nil, nil, false
 			}
 
+			if nextHop != nil {
+				localNode.TraceRelayMessage(relayMessage, pb.TRACE_FORWARDED, "")
+			}
+
 			unsignedMsg.Message, err = proto.Marshal(relayMessage)
 			if err != nil {
 				log.Errorf("marshal new relay message error: %v", err)
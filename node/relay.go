@@ -1,12 +1,15 @@
 package node
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/nknorg/nkn/block"
 	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/crypto"
+	"github.com/nknorg/nkn/crypto/util"
 	"github.com/nknorg/nkn/event"
 	"github.com/nknorg/nkn/pb"
 	"github.com/nknorg/nkn/por"
@@ -17,11 +20,17 @@ import (
 	"github.com/nknorg/nkn/vault"
 )
 
+const (
+	fragmentIDSize = 16
+)
+
 type RelayService struct {
 	sync.Mutex
 	wallet    vault.Wallet
 	localNode *LocalNode
 	porServer *por.PorServer
+	limiter   *relayLimiter
+	tracer    *relayTracer
 }
 
 func NewRelayService(wallet vault.Wallet, localNode *LocalNode) *RelayService {
@@ -29,6 +38,8 @@ func NewRelayService(wallet vault.Wallet, localNode *LocalNode) *RelayService {
 		wallet:    wallet,
 		localNode: localNode,
 		porServer: por.GetPorServer(),
+		limiter:   newRelayLimiter(),
+		tracer:    newRelayTracer(),
 	}
 	return service
 }
@@ -39,11 +50,12 @@ func (rs *RelayService) Start() error {
 	event.Queue.Subscribe(event.BacktrackSigChain, rs.backtrackDestSigChain)
 	rs.localNode.AddMessageHandler(pb.RELAY, rs.relayMessageHandler)
 	rs.localNode.AddMessageHandler(pb.BACKTRACK_SIGNATURE_CHAIN, rs.backtrackSigChainMessageHandler)
+	rs.localNode.AddMessageHandler(pb.RELAY_DELIVERY_ACK, rs.relayDeliveryAckMessageHandler)
 	return nil
 }
 
 // NewRelayMessage creates a RELAY message
-func NewRelayMessage(srcIdentifier string, srcPubkey, destID, payload, blockHash, signature []byte, maxHoldingSeconds uint32) (*pb.UnsignedMessage, error) {
+func NewRelayMessage(srcIdentifier string, srcPubkey, destID, payload, blockHash, signature []byte, maxHoldingSeconds uint32, messageID []byte, requestAck, trace bool) (*pb.UnsignedMessage, error) {
 	msgBody := &pb.Relay{
 		SrcIdentifier:     srcIdentifier,
 		SrcPubkey:         srcPubkey,
@@ -53,8 +65,15 @@ func NewRelayMessage(srcIdentifier string, srcPubkey, destID, payload, blockHash
 		BlockHash:         blockHash,
 		LastSignature:     signature,
 		SigChainLen:       1,
+		RequestAck:        requestAck,
+		MessageId:         messageID,
+		Trace:             trace && len(messageID) > 0,
 	}
 
+	return newRelayMessage(msgBody)
+}
+
+func newRelayMessage(msgBody *pb.Relay) (*pb.UnsignedMessage, error) {
 	buf, err := proto.Marshal(msgBody)
 	if err != nil {
 		return nil, err
@@ -68,6 +87,53 @@ func NewRelayMessage(srcIdentifier string, srcPubkey, destID, payload, blockHash
 	return msg, nil
 }
 
+// NewRelayFragmentMessages splits payload into fragments of at most
+// fragmentSize bytes and creates a RELAY message for each fragment. All
+// fragments carry the same source signature, and relay nodes on the same path
+// sign them identically, so fragments collapse into a single sigchain whose
+// data size is the full payload. The sigchain is backtracked by the receipt of
+// the reassembled message. Fragments that took a different path carry a
+// different last signature and are rejected by the receiver's reassembler.
+func NewRelayFragmentMessages(srcIdentifier string, srcPubkey, destID, payload, blockHash, signature []byte, maxHoldingSeconds uint32, messageID []byte, requestAck, trace bool, fragmentSize int) ([]*pb.UnsignedMessage, error) {
+	if fragmentSize <= 0 {
+		return nil, errors.New("fragment size should be greater than 0")
+	}
+
+	numFragments := (len(payload) + fragmentSize - 1) / fragmentSize
+	fragmentID := util.RandomBytes(fragmentIDSize)
+	msgs := make([]*pb.UnsignedMessage, 0, numFragments)
+	for i := 0; i < numFragments; i++ {
+		end := (i + 1) * fragmentSize
+		if end > len(payload) {
+			end = len(payload)
+		}
+
+		msg, err := newRelayMessage(&pb.Relay{
+			SrcIdentifier:     srcIdentifier,
+			SrcPubkey:         srcPubkey,
+			DestId:            destID,
+			Payload:           payload[i*fragmentSize : end],
+			MaxHoldingSeconds: maxHoldingSeconds,
+			BlockHash:         blockHash,
+			LastSignature:     signature,
+			SigChainLen:       1,
+			RequestAck:        requestAck,
+			MessageId:         messageID,
+			Trace:             trace && len(messageID) > 0,
+			FragmentId:        fragmentID,
+			FragmentIndex:     uint32(i),
+			NumFragments:      uint32(numFragments),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
 // relayMessageHandler handles a RELAY message
 func (rs *RelayService) relayMessageHandler(remoteMessage *RemoteMessage) ([]byte, bool, error) {
 	msgBody := &pb.Relay{}
@@ -76,11 +142,64 @@ func (rs *RelayService) relayMessageHandler(remoteMessage *RemoteMessage) ([]byt
 		return nil, false, err
 	}
 
+	err = rs.localNode.CheckClientAccess(address.AssembleClientAddress(msgBody.SrcIdentifier, msgBody.SrcPubkey))
+	if err != nil {
+		rs.traceDroppedRelayMessage(msgBody, err)
+		return nil, false, fmt.Errorf("drop relay message from %x: %v", msgBody.SrcPubkey, err)
+	}
+
+	err = rs.checkInboundRelayLimit(msgBody.SrcPubkey, len(msgBody.Payload))
+	if err != nil {
+		rs.traceDroppedRelayMessage(msgBody, err)
+		return nil, false, fmt.Errorf("drop relay message from %x: %v", msgBody.SrcPubkey, err)
+	}
+
 	event.Queue.Notify(event.SendInboundMessageToClient, msgBody)
 
 	return nil, false, nil
 }
 
+// NewRelayDeliveryAckMessage creates a RELAY_DELIVERY_ACK message
+func NewRelayDeliveryAckMessage(srcID []byte, ack *pb.DeliveryAck) (*pb.UnsignedMessage, error) {
+	msgBody := &pb.RelayDeliveryAck{
+		SrcId: srcID,
+		Ack:   ack,
+	}
+
+	buf, err := proto.Marshal(msgBody)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &pb.UnsignedMessage{
+		MessageType: pb.RELAY_DELIVERY_ACK,
+		Message:     buf,
+	}
+
+	return msg, nil
+}
+
+// relayDeliveryAckMessageHandler handles a RELAY_DELIVERY_ACK message
+func (rs *RelayService) relayDeliveryAckMessageHandler(remoteMessage *RemoteMessage) ([]byte, bool, error) {
+	msgBody := &pb.RelayDeliveryAck{}
+	err := proto.Unmarshal(remoteMessage.Message, msgBody)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if msgBody.Ack == nil {
+		return nil, false, errors.New("delivery ack is nil")
+	}
+
+	if len(msgBody.Ack.Trace) > 0 && len(msgBody.Ack.MessageId) > 0 {
+		rs.tracer.merge(msgBody.Ack.MessageId, msgBody.Ack.Trace)
+	}
+
+	event.Queue.Notify(event.SendDeliveryAckToClient, msgBody)
+
+	return nil, false, nil
+}
+
 // NewBacktrackSigChainMessage creates a BACKTRACK_SIGNATURE_CHAIN message
 func NewBacktrackSigChainMessage(sigChainElems []*pb.SigChainElem, prevSignature []byte) (*pb.UnsignedMessage, error) {
 	msgBody := &pb.BacktrackSignatureChain{
@@ -234,7 +353,20 @@ func (localNode *LocalNode) startRelayer() {
 	localNode.relayer.Start()
 }
 
-func (localNode *LocalNode) SendRelayMessage(srcAddr, destAddr string, payload, signature, blockHash []byte, nonce, maxHoldingSeconds uint32) error {
+func (localNode *LocalNode) SendRelayMessage(srcAddr, destAddr string, payload, signature, blockHash []byte, nonce, maxHoldingSeconds uint32, messageID []byte, requestAck, trace bool) (err error) {
+	if trace {
+		defer func() {
+			if err != nil {
+				localNode.traceDroppedMessage(messageID, err.Error())
+			}
+		}()
+	}
+
+	err = localNode.CheckDestAccess(destAddr)
+	if err != nil {
+		return err
+	}
+
 	srcID, srcPubkey, srcIdentifier, err := address.ParseClientAddress(srcAddr)
 	if err != nil {
 		return err
@@ -260,7 +392,67 @@ func (localNode *LocalNode) SendRelayMessage(srcAddr, destAddr string, payload,
 		return err
 	}
 
-	msg, err := NewRelayMessage(srcIdentifier, srcPubkey, destID, payload, blockHash, signature, maxHoldingSeconds)
+	return localNode.sendRelayMessage(srcIdentifier, srcPubkey, destID, payload, blockHash, signature, maxHoldingSeconds, messageID, requestAck, trace)
+}
+
+func (localNode *LocalNode) sendRelayMessage(srcIdentifier string, srcPubkey, destID, payload, blockHash, signature []byte, maxHoldingSeconds uint32, messageID []byte, requestAck, trace bool) error {
+	var msgs []*pb.UnsignedMessage
+	fragmentSize := int(config.Parameters.RelayFragmentSize)
+	if fragmentSize > 0 && len(payload) > fragmentSize {
+		var err error
+		msgs, err = NewRelayFragmentMessages(srcIdentifier, srcPubkey, destID, payload, blockHash, signature, maxHoldingSeconds, messageID, requestAck, trace, fragmentSize)
+		if err != nil {
+			return err
+		}
+	} else {
+		msg, err := NewRelayMessage(srcIdentifier, srcPubkey, destID, payload, blockHash, signature, maxHoldingSeconds, messageID, requestAck, trace)
+		if err != nil {
+			return err
+		}
+		msgs = []*pb.UnsignedMessage{msg}
+	}
+
+	for _, msg := range msgs {
+		buf, err := localNode.SerializeMessage(msg, false)
+		if err != nil {
+			return err
+		}
+
+		_, err = localNode.nnet.SendBytesRelayAsync(buf, destID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SendDeliveryAck sends a delivery ack of a relay message back to the source
+// client through relay path. The ack is signed by local node.
+func (localNode *LocalNode) SendDeliveryAck(relayMessage *pb.Relay, status pb.DeliveryStatus, missingFragments []uint32) error {
+	srcID, _, _, err := address.ParseClientAddress(address.AssembleClientAddress(relayMessage.SrcIdentifier, relayMessage.SrcPubkey))
+	if err != nil {
+		return err
+	}
+
+	ack := &pb.DeliveryAck{
+		MessageId:        relayMessage.MessageId,
+		DestId:           relayMessage.DestId,
+		Status:           status,
+		NodePubkey:       localNode.account.PublicKey.EncodePoint(),
+		MissingFragments: missingFragments,
+	}
+
+	if relayMessage.Trace {
+		ack.Trace = relayMessage.TraceHops
+	}
+
+	ack.Signature, err = crypto.Sign(localNode.account.PrivKey(), ack.GetHashForSigning())
+	if err != nil {
+		return err
+	}
+
+	msg, err := NewRelayDeliveryAckMessage(srcID, ack)
 	if err != nil {
 		return err
 	}
@@ -270,7 +462,7 @@ func (localNode *LocalNode) SendRelayMessage(srcAddr, destAddr string, payload,
 		return err
 	}
 
-	_, err = localNode.nnet.SendBytesRelayAsync(buf, destID)
+	_, err = localNode.nnet.SendBytesRelayAsync(buf, srcID)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,84 @@
+package node
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nknorg/nkn/crypto/util"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/por"
+	"github.com/nknorg/nkn/vault"
+)
+
+// TestRelayFragmentSigChain checks that fragments of a payload collapse into
+// one sigchain: every fragment carries the source signature of the whole
+// payload, and relay nodes on the same path produce identical signatures for
+// all fragments, so a single receipt backtracks one sigchain whose data size
+// is the full payload size.
+func TestRelayFragmentSigChain(t *testing.T) {
+	const fragmentSize = 500
+	payload := util.RandomBytes(fragmentSize*5/2 + 1)
+	blockHash := util.RandomBytes(32)
+	srcSignature := util.RandomBytes(64)
+	destID := util.RandomBytes(32)
+
+	msgs, err := NewRelayFragmentMessages("id", util.RandomBytes(32), destID, payload, blockHash, srcSignature, 0, util.RandomBytes(8), false, false, fragmentSize)
+	if err != nil {
+		t.Fatalf("create fragments error: %v", err)
+	}
+	if len(msgs) != 3 {
+		t.Fatalf("expect 3 fragments, got %d", len(msgs))
+	}
+
+	fragments := make([]*pb.Relay, 0, len(msgs))
+	reassembled := make([]byte, 0, len(payload))
+	for i, msg := range msgs {
+		relay := &pb.Relay{}
+		if err := proto.Unmarshal(msg.Message, relay); err != nil {
+			t.Fatalf("unmarshal fragment %d error: %v", i, err)
+		}
+		if relay.FragmentIndex != uint32(i) || relay.NumFragments != uint32(len(msgs)) {
+			t.Fatalf("fragment %d has index %d of %d", i, relay.FragmentIndex, relay.NumFragments)
+		}
+		if !bytes.Equal(relay.LastSignature, srcSignature) {
+			t.Fatalf("fragment %d does not carry source signature", i)
+		}
+		if len(fragments) > 0 && !bytes.Equal(relay.FragmentId, fragments[0].FragmentId) {
+			t.Fatalf("fragment %d has different fragment id", i)
+		}
+		fragments = append(fragments, relay)
+		reassembled = append(reassembled, relay.Payload...)
+	}
+	if !bytes.Equal(reassembled, payload) {
+		t.Fatal("reassembled payload is different from original payload")
+	}
+
+	var nextPubkey, prevNodeID []byte
+	for hop := 0; hop < 3; hop++ {
+		account, _ := vault.NewAccount()
+		nodeID := util.RandomBytes(32)
+		ps := por.NewPorServer(account, nodeID)
+		nextPubkey = util.RandomBytes(32)
+		for i, relay := range fragments {
+			if err := ps.Sign(relay, nextPubkey, prevNodeID, true); err != nil {
+				t.Fatalf("hop %d sign fragment %d error: %v", hop, i, err)
+			}
+		}
+		for i, relay := range fragments[1:] {
+			if !bytes.Equal(relay.LastSignature, fragments[0].LastSignature) || relay.SigChainLen != fragments[0].SigChainLen {
+				t.Fatalf("hop %d signs fragment %d differently from fragment 0", hop, i+1)
+			}
+		}
+		prevNodeID = nodeID
+	}
+
+	srcAccount, _ := vault.NewAccount()
+	sigChain, err := por.NewPorServer(srcAccount, util.RandomBytes(32)).CreateSigChainForClient(0, uint32(len(payload)), blockHash, util.RandomBytes(32), util.RandomBytes(32), destID, util.RandomBytes(32), srcSignature, pb.SIGNATURE)
+	if err != nil {
+		t.Fatalf("create source sigchain error: %v", err)
+	}
+	if sigChain.DataSize != uint32(len(payload)) {
+		t.Fatalf("expect sigchain data size %d, got %d", len(payload), sigChain.DataSize)
+	}
+}
@@ -0,0 +1,109 @@
+package node
+
+import (
+	"encoding/hex"
+	"errors"
+	"math"
+	"sync"
+
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/util/ratelimit"
+)
+
+var ErrPayloadTooLarge = errors.New("payload size exceeds limit")
+
+// relayLimiter limits relay traffic of clients by public key and by ip.
+// Outbound traffic is sent by clients connected to local node, and inbound
+// traffic is sent by remote clients to clients connected to local node.
+type relayLimiter struct {
+	outboundLock   sync.Mutex // makes outbound checks across limiters atomic
+	outboundClient *ratelimit.RateLimiter
+	outboundIP     *ratelimit.RateLimiter
+	inboundClient  *ratelimit.RateLimiter
+}
+
+// RelayUsage is the current relay usage of clients
+type RelayUsage struct {
+	OutboundClients map[string]ratelimit.Usage `json:"outboundClients"`
+	OutboundIPs     map[string]ratelimit.Usage `json:"outboundIPs"`
+	InboundClients  map[string]ratelimit.Usage `json:"inboundClients"`
+}
+
+// newRelayLimiter creates relay limiters. Inbound burst allows a single
+// message of max payload size, and outbound burst allows a single message of
+// max payload size sent to max number of destinations, while requests larger
+// than the burst are rejected.
+func newRelayLimiter() *relayLimiter {
+	clientMsgs := float64(config.Parameters.RelayClientMaxMsgsPerSecond)
+	clientBytes := float64(config.Parameters.RelayClientMaxBytesPerSecond)
+	ipMsgs := float64(config.Parameters.RelayIPMaxMsgsPerSecond)
+	ipBytes := float64(config.Parameters.RelayIPMaxBytesPerSecond)
+	maxPayloadSize := float64(config.Parameters.RelayMaxPayloadSize)
+	maxDests := float64(config.Parameters.MaxPublishDests)
+	if maxDests < 1 {
+		maxDests = 1
+	}
+	return &relayLimiter{
+		outboundClient: ratelimit.NewRateLimiterWithBurst(clientMsgs, clientBytes, math.Max(clientMsgs, maxDests), maxPayloadSize*maxDests),
+		outboundIP:     ratelimit.NewRateLimiterWithBurst(ipMsgs, ipBytes, math.Max(ipMsgs, maxDests), maxPayloadSize*maxDests),
+		inboundClient:  ratelimit.NewRateLimiterWithBurst(clientMsgs, clientBytes, clientMsgs, maxPayloadSize),
+	}
+}
+
+func checkPayloadSize(payloadSize int) error {
+	if config.Parameters.RelayMaxPayloadSize > 0 && payloadSize > int(config.Parameters.RelayMaxPayloadSize) {
+		return ErrPayloadTooLarge
+	}
+	return nil
+}
+
+// CheckOutboundRelayLimit checks and consumes relay quota of a client connected
+// to local node that sends payload to numDests destinations. Quota is consumed
+// only if both the client limit and the ip limit allow it.
+func (localNode *LocalNode) CheckOutboundRelayLimit(pubKey []byte, ip string, payloadSize, numDests int) error {
+	return localNode.relayer.limiter.checkOutbound(hex.EncodeToString(pubKey), ip, payloadSize, numDests)
+}
+
+func (rl *relayLimiter) checkOutbound(client, ip string, payloadSize, numDests int) error {
+	err := checkPayloadSize(payloadSize)
+	if err != nil {
+		return err
+	}
+
+	numBytes := payloadSize * numDests
+
+	rl.outboundLock.Lock()
+	defer rl.outboundLock.Unlock()
+
+	err = rl.outboundIP.Check(ip, numDests, numBytes)
+	if err != nil {
+		return err
+	}
+
+	err = rl.outboundClient.Allow(client, numDests, numBytes)
+	if err != nil {
+		return err
+	}
+
+	return rl.outboundIP.Allow(ip, numDests, numBytes)
+}
+
+// checkInboundRelayLimit checks and consumes relay quota of a remote client
+// that sends payload to clients connected to local node.
+func (rs *RelayService) checkInboundRelayLimit(srcPubkey []byte, payloadSize int) error {
+	err := checkPayloadSize(payloadSize)
+	if err != nil {
+		return err
+	}
+
+	return rs.limiter.inboundClient.Allow(hex.EncodeToString(srcPubkey), 1, payloadSize)
+}
+
+// GetRelayUsage returns the current relay usage of clients
+func (localNode *LocalNode) GetRelayUsage() *RelayUsage {
+	return &RelayUsage{
+		OutboundClients: localNode.relayer.limiter.outboundClient.GetUsage(),
+		OutboundIPs:     localNode.relayer.limiter.outboundIP.GetUsage(),
+		InboundClients:  localNode.relayer.limiter.inboundClient.GetUsage(),
+	}
+}
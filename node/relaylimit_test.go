@@ -0,0 +1,45 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/util/ratelimit"
+)
+
+func TestRelayLimiterCheckOutbound(t *testing.T) {
+	defer func(params config.Configuration) { config.Parameters = &params }(*config.Parameters)
+	config.Parameters.MaxPublishDests = 10
+	config.Parameters.RelayMaxPayloadSize = 1000
+	config.Parameters.RelayClientMaxMsgsPerSecond = 5
+	config.Parameters.RelayClientMaxBytesPerSecond = 1000
+	config.Parameters.RelayIPMaxMsgsPerSecond = 5
+	config.Parameters.RelayIPMaxBytesPerSecond = 1000
+
+	rl := newRelayLimiter()
+
+	tests := []struct {
+		name        string
+		client      string
+		ip          string
+		payloadSize int
+		numDests    int
+		err         error
+	}{
+		{"payload too large", "a", "ip1", 1001, 1, ErrPayloadTooLarge},
+		{"max payload to max dests", "a", "ip1", 1000, 10, nil},
+		{"client and ip exhausted", "a", "ip1", 1, 1, ratelimit.ErrMsgRateExceeded},
+		{"over burst", "b", "ip2", 1000, 11, ratelimit.ErrMsgBurstExceeded},
+		{"ip exhausted", "c", "ip1", 1, 1, ratelimit.ErrMsgRateExceeded},
+		// client c should not have spent quota when rejected by ip limit
+		{"client quota kept after ip rejected", "c", "ip2", 1000, 10, nil},
+		{"ip quota kept after client rejected", "c", "ip3", 1, 1, ratelimit.ErrMsgRateExceeded},
+		{"ip3 still has full quota", "d", "ip3", 1000, 10, nil},
+	}
+
+	for _, test := range tests {
+		if err := rl.checkOutbound(test.client, test.ip, test.payloadSize, test.numDests); err != test.err {
+			t.Errorf("%s: expect %v, got %v", test.name, test.err, err)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package node
+
+import (
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/util/log"
+)
+
+const (
+	traceCacheExpiration      = 10 * time.Minute
+	traceCacheCleanupInterval = time.Minute
+	maxTraceHops              = 64
+)
+
+// RelayTraceHop is the json format of a relay trace hop
+type RelayTraceHop struct {
+	NodeID    string `json:"nodeId"`
+	Timestamp int64  `json:"timestamp"`
+	Decision  string `json:"decision"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// relayTracer keeps relay trace hops of traced messages by message id. It
+// contains hops recorded by local node, and the full trace if local node is
+// the source and the trace is returned by destination.
+type relayTracer struct {
+	sync.Mutex
+	cache common.Cache
+}
+
+func newRelayTracer() *relayTracer {
+	return &relayTracer{
+		cache: common.NewGoCache(traceCacheExpiration, traceCacheCleanupInterval),
+	}
+}
+
+func (rt *relayTracer) get(messageID []byte) []*pb.RelayTraceHop {
+	rt.Lock()
+	defer rt.Unlock()
+	v, ok := rt.cache.Get(messageID)
+	if !ok {
+		return nil
+	}
+	hops, _ := v.([]*pb.RelayTraceHop)
+	return hops
+}
+
+func (rt *relayTracer) add(messageID []byte, hop *pb.RelayTraceHop) {
+	rt.Lock()
+	defer rt.Unlock()
+	var hops []*pb.RelayTraceHop
+	if v, ok := rt.cache.Get(messageID); ok {
+		hops, _ = v.([]*pb.RelayTraceHop)
+	}
+	rt.cache.Set(messageID, append(hops, hop))
+}
+
+// merge replaces hops of message with the full trace if it contains all hops
+// recorded locally.
+func (rt *relayTracer) merge(messageID []byte, trace []*pb.RelayTraceHop) {
+	rt.Lock()
+	defer rt.Unlock()
+	if v, ok := rt.cache.Get(messageID); ok {
+		if hops, _ := v.([]*pb.RelayTraceHop); len(hops) > len(trace) {
+			return
+		}
+	}
+	rt.cache.Set(messageID, trace)
+}
+
+// TraceRelayMessage appends a hop of local node with decision to the trace of
+// relay message if it's traced, and records the hop locally.
+func (localNode *LocalNode) TraceRelayMessage(relayMessage *pb.Relay, decision pb.RelayTraceDecision, reason string) {
+	if !relayMessage.Trace || len(relayMessage.MessageId) == 0 {
+		return
+	}
+
+	hop := &pb.RelayTraceHop{
+		NodeId:    localNode.GetChordID(),
+		Timestamp: time.Now().UnixNano(),
+		Decision:  decision,
+		Reason:    reason,
+	}
+
+	if len(relayMessage.TraceHops) < maxTraceHops {
+		relayMessage.TraceHops = append(relayMessage.TraceHops, hop)
+	}
+
+	localNode.relayer.tracer.add(relayMessage.MessageId, hop)
+}
+
+// traceDroppedRelayMessage records that a relay message to local node is
+// dropped, and sends the trace back to source if the message is traced.
+func (rs *RelayService) traceDroppedRelayMessage(relayMessage *pb.Relay, reason error) {
+	if !relayMessage.Trace {
+		return
+	}
+
+	rs.localNode.TraceRelayMessage(relayMessage, pb.TRACE_DROPPED, reason.Error())
+
+	err := rs.localNode.SendDeliveryAck(relayMessage, pb.DROPPED, nil)
+	if err != nil {
+		log.Warningf("Send delivery ack error: %v", err)
+	}
+}
+
+// traceDroppedMessage records locally that a traced message is dropped by
+// local node before a relay message is created.
+func (localNode *LocalNode) traceDroppedMessage(messageID []byte, reason string) {
+	if len(messageID) == 0 {
+		return
+	}
+
+	localNode.relayer.tracer.add(messageID, &pb.RelayTraceHop{
+		NodeId:    localNode.GetChordID(),
+		Timestamp: time.Now().UnixNano(),
+		Decision:  pb.TRACE_DROPPED,
+		Reason:    reason,
+	})
+}
+
+// GetRelayTrace returns the relay trace of a message known by local node
+func (localNode *LocalNode) GetRelayTrace(messageID []byte) []*RelayTraceHop {
+	hops := localNode.relayer.tracer.get(messageID)
+	if hops == nil {
+		return nil
+	}
+
+	trace := make([]*RelayTraceHop, 0, len(hops))
+	for _, hop := range hops {
+		trace = append(trace, &RelayTraceHop{
+			NodeID:    hex.EncodeToString(hop.NodeId),
+			Timestamp: hop.Timestamp,
+			Decision:  hop.Decision.String(),
+			Reason:    hop.Reason,
+		})
+	}
+
+	return trace
+}
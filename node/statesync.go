@@ -0,0 +1,438 @@
+package node
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/nknorg/nkn/block"
+	"github.com/nknorg/nkn/chain"
+	"github.com/nknorg/nkn/chain/store"
+	"github.com/nknorg/nkn/chain/trie"
+	"github.com/nknorg/nkn/common"
+	"github.com/nknorg/nkn/pb"
+	"github.com/nknorg/nkn/util/config"
+	"github.com/nknorg/nkn/util/log"
+)
+
+const (
+	maxSyncStateNodesBatchSize = 256
+	stateSyncPivotDistance     = 64
+	stateSyncMinRelativeWeight = 1.0 / 2.0
+)
+
+// NewGetStateNodesMessage creates a GET_STATE_NODES message
+func NewGetStateNodesMessage(hashes [][]byte) (*pb.UnsignedMessage, error) {
+	msgBody := &pb.GetStateNodes{
+		Hashes: hashes,
+	}
+
+	buf, err := proto.Marshal(msgBody)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &pb.UnsignedMessage{
+		MessageType: pb.GET_STATE_NODES,
+		Message:     buf,
+	}
+
+	return msg, nil
+}
+
+// NewGetStateNodesReply creates a GET_STATE_NODES_REPLY message in respond to
+// GET_STATE_NODES message
+func NewGetStateNodesReply(nodes [][]byte) (*pb.UnsignedMessage, error) {
+	msgBody := &pb.GetStateNodesReply{
+		Nodes: nodes,
+	}
+
+	buf, err := proto.Marshal(msgBody)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &pb.UnsignedMessage{
+		MessageType: pb.GET_STATE_NODES_REPLY,
+		Message:     buf,
+	}
+
+	return msg, nil
+}
+
+// getStateNodesMessageHandler handles a GET_STATE_NODES message. Nodes that
+// are not found locally are replied as empty bytes.
+func (localNode *LocalNode) getStateNodesMessageHandler(remoteMessage *RemoteMessage) ([]byte, bool, error) {
+	replyMsg, err := NewGetStateNodesReply(nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	replyBuf, err := localNode.SerializeMessage(replyMsg, false)
+	if err != nil {
+		return nil, false, err
+	}
+
+	msgBody := &pb.GetStateNodes{}
+	err = proto.Unmarshal(remoteMessage.Message, msgBody)
+	if err != nil {
+		return replyBuf, false, err
+	}
+
+	if len(msgBody.Hashes) > maxSyncStateNodesBatchSize {
+		return replyBuf, false, nil
+	}
+
+	nodes := make([][]byte, len(msgBody.Hashes))
+	for i, hash := range msgBody.Hashes {
+		node, err := chain.DefaultLedger.Store.GetStateNode(hash)
+		if err != nil {
+			continue
+		}
+		nodes[i] = node
+	}
+
+	replyMsg, err = NewGetStateNodesReply(nodes)
+	if err != nil {
+		return replyBuf, false, err
+	}
+
+	replyBuf, err = localNode.SerializeMessage(replyMsg, false)
+	return replyBuf, false, err
+}
+
+// GetStateNodes requests state trie nodes by hash from a neighbor using
+// GET_STATE_NODES message. Each returned node is verified against its hash.
+// Nodes that the neighbor does not have are returned as nil.
+func (remoteNode *RemoteNode) GetStateNodes(hashes [][]byte) ([][]byte, error) {
+	msg, err := NewGetStateNodesMessage(hashes)
+	if err != nil {
+		return nil, err
+	}
+
+	buf, err := remoteNode.localNode.SerializeMessage(msg, false)
+	if err != nil {
+		return nil, err
+	}
+
+	replyBytes, err := remoteNode.SendBytesSyncWithTimeout(buf, syncReplyTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	replyMsg := &pb.GetStateNodesReply{}
+	err = proto.Unmarshal(replyBytes, replyMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(replyMsg.Nodes) != len(hashes) {
+		return nil, fmt.Errorf("result contains %d instead of %d state nodes", len(replyMsg.Nodes), len(hashes))
+	}
+
+	nodes := make([][]byte, len(hashes))
+	for i, node := range replyMsg.Nodes {
+		if len(node) == 0 {
+			continue
+		}
+		if !trie.VerifyNode(hashes[i], node) {
+			return nil, fmt.Errorf("state node does not match hash %x", hashes[i])
+		}
+		nodes[i] = node
+	}
+
+	return nodes, nil
+}
+
+// getNeighborsAgreedOnBlockHash returns the neighbors whose block hash at a
+// given height is blockHash, or error if they are not the majority of all
+// neighbors that replied.
+func (localNode *LocalNode) getNeighborsAgreedOnBlockHash(height uint32, blockHash common.Uint256, neighbors []*RemoteNode) ([]*RemoteNode, error) {
+	allHeaders, err := localNode.getNeighborsBlockHeaderByHeight(height, neighbors)
+	if err != nil {
+		return nil, err
+	}
+
+	neighborByID := make(map[string]*RemoteNode, len(neighbors))
+	for _, neighbor := range neighbors {
+		neighborByID[neighbor.GetID()] = neighbor
+	}
+
+	agreed := make([]*RemoteNode, 0, len(neighbors))
+	totalCount := 0
+	allHeaders.Range(func(key, value interface{}) bool {
+		if header, ok := value.(*block.Header); ok && header != nil {
+			totalCount++
+			if header.Hash() == blockHash {
+				if id, ok := key.(string); ok && neighborByID[id] != nil {
+					agreed = append(agreed, neighborByID[id])
+				}
+			}
+		}
+		return true
+	})
+
+	if len(agreed) == 0 || len(agreed) <= int(stateSyncMinRelativeWeight*float32(totalCount)) {
+		return nil, fmt.Errorf("only %d of %d neighbors agree on block hash %s at height %d", len(agreed), totalCount, blockHash.ToHexString(), height)
+	}
+
+	return agreed, nil
+}
+
+// syncStateNodes downloads all missing state trie nodes reachable from root
+// hash from given neighbors and saves them to local ledger.
+func (localNode *LocalNode) syncStateNodes(root common.Uint256, neighbors []*RemoteNode) (int, error) {
+	var pending [][]byte
+	if !chain.DefaultLedger.Store.HasStateNode(root.ToArray()) {
+		pending = append(pending, root.ToArray())
+	}
+
+	numSynced := 0
+	numFails := 0
+	for len(pending) > 0 {
+		numBatches := (len(pending)-1)/maxSyncStateNodesBatchSize + 1
+		if numBatches > len(neighbors) {
+			numBatches = len(neighbors)
+		}
+
+		batches := make([][][]byte, numBatches)
+		for i := range batches {
+			size := maxSyncStateNodesBatchSize
+			if size > len(pending) {
+				size = len(pending)
+			}
+			batches[i] = pending[:size]
+			pending = pending[size:]
+		}
+
+		results := make([][][]byte, numBatches)
+		var wg sync.WaitGroup
+		for i := range batches {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				neighbor := neighbors[(numFails+i)%len(neighbors)]
+				nodes, err := neighbor.GetStateNodes(batches[i])
+				if err != nil {
+					log.Warningf("Get state nodes from neighbor %v error: %v", neighbor.GetID(), err)
+					return
+				}
+				results[i] = nodes
+			}(i)
+		}
+		wg.Wait()
+
+		progress := false
+		for i, hashes := range batches {
+			for j, hash := range hashes {
+				if results[i] == nil || results[i][j] == nil {
+					pending = append(pending, hash)
+					continue
+				}
+
+				err := chain.DefaultLedger.Store.PutStateNode(hash, results[i][j])
+				if err != nil {
+					return numSynced, err
+				}
+				numSynced++
+				progress = true
+
+				children, err := trie.ChildrenHashes(results[i][j])
+				if err != nil {
+					return numSynced, err
+				}
+				for _, child := range children {
+					if !chain.DefaultLedger.Store.HasStateNode(child) {
+						pending = append(pending, child)
+					}
+				}
+			}
+		}
+
+		if progress {
+			numFails = 0
+		} else {
+			numFails++
+			if numFails >= maxSyncWorkerFails*len(neighbors) {
+				return numSynced, fmt.Errorf("failed to get %d state nodes from neighbors", len(pending))
+			}
+		}
+	}
+
+	return numSynced, nil
+}
+
+// syncStateProof downloads the state trie nodes on the path of key under root
+// hash from given neighbors and saves them to local ledger.
+func (localNode *LocalNode) syncStateProof(root common.Uint256, key []byte, neighbors []*RemoteNode) error {
+	var proof [][]byte
+	numFails := 0
+	for {
+		_, err := trie.VerifyProof(root, key, proof)
+		if err == nil {
+			break
+		}
+
+		missingNodeErr, ok := err.(*trie.MissingNodeError)
+		if !ok {
+			return err
+		}
+
+		node, err := chain.DefaultLedger.Store.GetStateNode(missingNodeErr.Hash)
+		if err != nil {
+			neighbor := neighbors[numFails%len(neighbors)]
+			var nodes [][]byte
+			nodes, err = neighbor.GetStateNodes([][]byte{missingNodeErr.Hash})
+			if err != nil || nodes[0] == nil {
+				numFails++
+				if numFails >= maxSyncWorkerFails*len(neighbors) {
+					return fmt.Errorf("failed to get state node %x from neighbors", missingNodeErr.Hash)
+				}
+				continue
+			}
+			node = nodes[0]
+			err = chain.DefaultLedger.Store.PutStateNode(missingNodeErr.Hash, node)
+			if err != nil {
+				return err
+			}
+		}
+
+		proof = append(proof, node)
+	}
+
+	return nil
+}
+
+// verifySyncedBlocks checks that blocks fetched from a neighbor match the
+// verified header hashes starting from the same height, and that their
+// transactions match the transactions root in header.
+func verifySyncedBlocks(blocks []*block.Block, headersHash []common.Uint256) error {
+	for i, b := range blocks {
+		blockHash := b.Hash()
+		if blockHash != headersHash[i] {
+			return fmt.Errorf("block hash %s is different from header hash at height %d", blockHash.ToHexString(), b.Header.UnsignedHeader.Height)
+		}
+
+		if err := chain.TransactionsRootCheck(b); err != nil {
+			return fmt.Errorf("block %d: %v", b.Header.UnsignedHeader.Height, err)
+		}
+	}
+
+	return nil
+}
+
+// syncStateSnapshot syncs the states at pivot height from neighbors that agree
+// on the pivot block hash, together with the blocks needed to continue syncing
+// from pivot height, and sets the pivot block as the current block.
+// headersHash contains the verified header hashes starting from height
+// currentHeight+1.
+func (localNode *LocalNode) syncStateSnapshot(currentHeight, pivotHeight uint32, neighbors []*RemoteNode, headersHash []common.Uint256) error {
+	pivotHash := headersHash[pivotHeight-currentHeight-1]
+
+	neighbors, err := localNode.getNeighborsAgreedOnBlockHash(pivotHeight, pivotHash, neighbors)
+	if err != nil {
+		return err
+	}
+
+	var startHeight uint32
+	if pivotHeight > config.MaxRollbackBlocks {
+		startHeight = pivotHeight - config.MaxRollbackBlocks + 1
+	}
+	if startHeight <= currentHeight {
+		startHeight = currentHeight + 1
+	}
+
+	blocks := make([]*block.Block, 0, pivotHeight-startHeight+1)
+	for height := startHeight; height <= pivotHeight; height += maxSyncBlocksBatchSize {
+		batchEndHeight := height + maxSyncBlocksBatchSize - 1
+		if batchEndHeight > pivotHeight {
+			batchEndHeight = pivotHeight
+		}
+
+		var batchBlocks []*block.Block
+		for i := 0; i < maxSyncWorkerFails*len(neighbors); i++ {
+			batchBlocks, err = neighbors[i%len(neighbors)].GetBlocks(height, batchEndHeight)
+			if err == nil {
+				batchBlocks = batchBlocks[:batchEndHeight-height+1]
+				err = verifySyncedBlocks(batchBlocks, headersHash[height-currentHeight-1:])
+			}
+			if err == nil {
+				break
+			}
+			log.Warningf("Get blocks error: %v", err)
+		}
+		if err != nil {
+			return err
+		}
+
+		blocks = append(blocks, batchBlocks...)
+	}
+
+	pivotBlock := blocks[len(blocks)-1]
+	stateRoot, err := common.Uint256ParseFromBytes(pivotBlock.Header.UnsignedHeader.StateRoot)
+	if err != nil {
+		return err
+	}
+
+	err = chain.DefaultLedger.Store.StartStateSync(stateRoot)
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	numStateNodes, err := localNode.syncStateNodes(stateRoot, neighbors)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Synced %d state nodes at height %d in %s", numStateNodes, pivotHeight, time.Since(startTime))
+
+	// donation at the last adjust height is calculated from the states prior to
+	// that height, which are not included in the pivot states
+	var donationStateRoot common.Uint256
+	donationHeight := pivotHeight / uint32(config.RewardAdjustInterval) * uint32(config.RewardAdjustInterval)
+	if donationHeight > 0 {
+		var donationHeader *block.Header
+		if donationHeight-1 <= currentHeight {
+			donationHeader, err = chain.DefaultLedger.Store.GetHeaderByHeight(donationHeight - 1)
+			if err != nil {
+				return err
+			}
+		} else {
+			var headers []*block.Header
+			for i := 0; i < maxSyncWorkerFails*len(neighbors); i++ {
+				headers, err = neighbors[i%len(neighbors)].GetBlockHeaders(donationHeight-1, donationHeight-1)
+				if err == nil {
+					break
+				}
+				log.Warningf("Get block headers error: %v", err)
+			}
+			if err != nil {
+				return err
+			}
+			if headers[0].Hash() != headersHash[donationHeight-1-currentHeight-1] {
+				return fmt.Errorf("header hash at height %d is different from synced header hash", donationHeight-1)
+			}
+			donationHeader = headers[0]
+		}
+
+		donationStateRoot, err = common.Uint256ParseFromBytes(donationHeader.UnsignedHeader.StateRoot)
+		if err != nil {
+			return err
+		}
+
+		donationAddress, err := common.ToScriptHash(config.DonationAddress)
+		if err != nil {
+			return err
+		}
+
+		err = localNode.syncStateProof(donationStateRoot, store.AccountStateKey(donationAddress), neighbors)
+		if err != nil {
+			return err
+		}
+	}
+
+	return chain.DefaultLedger.Blockchain.SaveStateSnapshot(blocks, donationStateRoot)
+}
@@ -299,6 +299,7 @@ func (remoteNode *RemoteNode) GetBlocks(startHeight, endHeight uint32) ([]*block
 func (localNode *LocalNode) initSyncing() {
 	localNode.AddMessageHandler(pb.GET_BLOCK_HEADERS, localNode.getBlockHeadersMessageHandler)
 	localNode.AddMessageHandler(pb.GET_BLOCKS, localNode.getBlocksMessageHandler)
+	localNode.AddMessageHandler(pb.GET_STATE_NODES, localNode.getStateNodesMessageHandler)
 	localNode.ResetSyncing()
 }
 
@@ -348,6 +349,17 @@ func (localNode *LocalNode) StartSyncing(stopHash common.Uint256, stopHeight uin
 
 		log.Infof("Synced %d block headers in %s", stopHeight-currentHeight, time.Since(startTime))
 
+		if config.Parameters.SyncMode == config.SyncModeSnapshot && currentHeight == 0 && stopHeight > stateSyncPivotDistance {
+			pivotHeight := stopHeight - stateSyncPivotDistance
+			startTime = time.Now()
+			err = localNode.syncStateSnapshot(currentHeight, pivotHeight, neighbors, headersHash)
+			if err != nil {
+				log.Errorf("Sync state snapshot error, fallback to full sync: %v", err)
+			} else {
+				log.Infof("Synced state snapshot at height %d in %s", pivotHeight, time.Since(startTime))
+			}
+		}
+
 		startTime = time.Now()
 		for syncBlocksBatchSize := config.Parameters.SyncBlocksBatchSize; syncBlocksBatchSize > 0; syncBlocksBatchSize /= 2 {
 			numSyncedBlocks := chain.DefaultLedger.Store.GetHeight() - currentHeight
@@ -358,7 +358,7 @@ func (localNode *LocalNode) handleTransactionsMessage(txnMsg *pb.Transactions) (
 		}
 
 		err := localNode.AppendTxnPool(txn)
-		if err == pool.ErrDuplicatedTx || err == pool.ErrRejectLowPriority {
+		if err == pool.ErrDuplicatedTx || err == pool.ErrRejectLowPriority || err == pool.ErrReplaceUnderpriced || err == pool.ErrFeeRateTooLow {
 			return false, nil
 		}
 		if err != nil {
@@ -520,5 +520,6 @@ func (localNode *LocalNode) requestSignatureChainTransaction(neighbor *RemoteNod
 func (localNode *LocalNode) cleanupTransactions(v interface{}) {
 	if block, ok := v.(*block.Block); ok {
 		localNode.TxnPool.CleanSubmittedTransactions(block.Transactions)
+		localNode.TxnPool.SetTxnsConfirmed(block.Transactions, block.Header.UnsignedHeader.Height)
 	}
 }
@@ -34,21 +34,76 @@ const (
 	OUTBOUND_MESSAGE ClientMessageType = 0
 	INBOUND_MESSAGE  ClientMessageType = 1
 	RECEIPT          ClientMessageType = 2
+	DELIVERY_ACK     ClientMessageType = 3
 )
 
 var ClientMessageType_name = map[int32]string{
 	0: "OUTBOUND_MESSAGE",
 	1: "INBOUND_MESSAGE",
 	2: "RECEIPT",
+	3: "DELIVERY_ACK",
 }
 var ClientMessageType_value = map[string]int32{
 	"OUTBOUND_MESSAGE": 0,
 	"INBOUND_MESSAGE":  1,
 	"RECEIPT":          2,
+	"DELIVERY_ACK":     3,
 }
 
 func (ClientMessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_clientmessage_c021a6273ba926f1, []int{0}
+	return fileDescriptor_clientmessage_e75ddb01f33e1ea8, []int{0}
+}
+
+type DeliveryStatus int32
+
+const (
+	DELIVERED  DeliveryStatus = 0
+	BUFFERED   DeliveryStatus = 1
+	DROPPED    DeliveryStatus = 2
+	INCOMPLETE DeliveryStatus = 3
+)
+
+var DeliveryStatus_name = map[int32]string{
+	0: "DELIVERED",
+	1: "BUFFERED",
+	2: "DROPPED",
+	3: "INCOMPLETE",
+}
+var DeliveryStatus_value = map[string]int32{
+	"DELIVERED":  0,
+	"BUFFERED":   1,
+	"DROPPED":    2,
+	"INCOMPLETE": 3,
+}
+
+func (DeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_clientmessage_e75ddb01f33e1ea8, []int{1}
+}
+
+type RelayTraceDecision int32
+
+const (
+	TRACE_FORWARDED RelayTraceDecision = 0
+	TRACE_DELIVERED RelayTraceDecision = 1
+	TRACE_BUFFERED  RelayTraceDecision = 2
+	TRACE_DROPPED   RelayTraceDecision = 3
+)
+
+var RelayTraceDecision_name = map[int32]string{
+	0: "TRACE_FORWARDED",
+	1: "TRACE_DELIVERED",
+	2: "TRACE_BUFFERED",
+	3: "TRACE_DROPPED",
+}
+var RelayTraceDecision_value = map[string]int32{
+	"TRACE_FORWARDED": 0,
+	"TRACE_DELIVERED": 1,
+	"TRACE_BUFFERED":  2,
+	"TRACE_DROPPED":   3,
+}
+
+func (RelayTraceDecision) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_clientmessage_e75ddb01f33e1ea8, []int{2}
 }
 
 type ClientMessage struct {
@@ -59,7 +114,7 @@ type ClientMessage struct {
 func (m *ClientMessage) Reset()      { *m = ClientMessage{} }
 func (*ClientMessage) ProtoMessage() {}
 func (*ClientMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clientmessage_c021a6273ba926f1, []int{0}
+	return fileDescriptor_clientmessage_e75ddb01f33e1ea8, []int{0}
 }
 func (m *ClientMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -110,12 +165,17 @@ type OutboundMessage struct {
 	Nonce             uint32   `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	BlockHash         []byte   `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 	Signatures        [][]byte `protobuf:"bytes,7,rep,name=signatures" json:"signatures,omitempty"`
+	Topic             string   `protobuf:"bytes,8,opt,name=topic,proto3" json:"topic,omitempty"`
+	RequestAck        bool     `protobuf:"varint,9,opt,name=request_ack,json=requestAck,proto3" json:"request_ack,omitempty"`
+	MessageId         []byte   `protobuf:"bytes,10,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// Record relay path of the message, only works with non-empty message_id
+	Trace bool `protobuf:"varint,11,opt,name=trace,proto3" json:"trace,omitempty"`
 }
 
 func (m *OutboundMessage) Reset()      { *m = OutboundMessage{} }
 func (*OutboundMessage) ProtoMessage() {}
 func (*OutboundMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clientmessage_c021a6273ba926f1, []int{1}
+	return fileDescriptor_clientmessage_e75ddb01f33e1ea8, []int{1}
 }
 func (m *OutboundMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -193,6 +253,34 @@ func (m *OutboundMessage) GetSignatures() [][]byte {
 	return nil
 }
 
+func (m *OutboundMessage) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *OutboundMessage) GetRequestAck() bool {
+	if m != nil {
+		return m.RequestAck
+	}
+	return false
+}
+
+func (m *OutboundMessage) GetMessageId() []byte {
+	if m != nil {
+		return m.MessageId
+	}
+	return nil
+}
+
+func (m *OutboundMessage) GetTrace() bool {
+	if m != nil {
+		return m.Trace
+	}
+	return false
+}
+
 type InboundMessage struct {
 	Src           string `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
 	Payload       []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
@@ -202,7 +290,7 @@ type InboundMessage struct {
 func (m *InboundMessage) Reset()      { *m = InboundMessage{} }
 func (*InboundMessage) ProtoMessage() {}
 func (*InboundMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clientmessage_c021a6273ba926f1, []int{2}
+	return fileDescriptor_clientmessage_e75ddb01f33e1ea8, []int{2}
 }
 func (m *InboundMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -260,7 +348,7 @@ type Receipt struct {
 func (m *Receipt) Reset()      { *m = Receipt{} }
 func (*Receipt) ProtoMessage() {}
 func (*Receipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_clientmessage_c021a6273ba926f1, []int{3}
+	return fileDescriptor_clientmessage_e75ddb01f33e1ea8, []int{3}
 }
 func (m *Receipt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -303,12 +391,176 @@ func (m *Receipt) GetSignature() []byte {
 	return nil
 }
 
+type DeliveryAck struct {
+	MessageId        []byte         `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	DestId           []byte         `protobuf:"bytes,2,opt,name=dest_id,json=destId,proto3" json:"dest_id,omitempty"`
+	Status           DeliveryStatus `protobuf:"varint,3,opt,name=status,proto3,enum=pb.DeliveryStatus" json:"status,omitempty"`
+	NodePubkey       []byte         `protobuf:"bytes,4,opt,name=node_pubkey,json=nodePubkey,proto3" json:"node_pubkey,omitempty"`
+	Signature        []byte         `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	MissingFragments []uint32       `protobuf:"varint,6,rep,packed,name=missing_fragments,json=missingFragments" json:"missing_fragments,omitempty"`
+	// Relay path of traced message. It is not covered by signature since hops
+	// are recorded by each node without signing.
+	Trace []*RelayTraceHop `protobuf:"bytes,7,rep,name=trace" json:"trace,omitempty"`
+}
+
+func (m *DeliveryAck) Reset()      { *m = DeliveryAck{} }
+func (*DeliveryAck) ProtoMessage() {}
+func (*DeliveryAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_clientmessage_e75ddb01f33e1ea8, []int{4}
+}
+func (m *DeliveryAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeliveryAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeliveryAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *DeliveryAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliveryAck.Merge(dst, src)
+}
+func (m *DeliveryAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeliveryAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliveryAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliveryAck proto.InternalMessageInfo
+
+func (m *DeliveryAck) GetMessageId() []byte {
+	if m != nil {
+		return m.MessageId
+	}
+	return nil
+}
+
+func (m *DeliveryAck) GetDestId() []byte {
+	if m != nil {
+		return m.DestId
+	}
+	return nil
+}
+
+func (m *DeliveryAck) GetStatus() DeliveryStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DELIVERED
+}
+
+func (m *DeliveryAck) GetNodePubkey() []byte {
+	if m != nil {
+		return m.NodePubkey
+	}
+	return nil
+}
+
+func (m *DeliveryAck) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *DeliveryAck) GetMissingFragments() []uint32 {
+	if m != nil {
+		return m.MissingFragments
+	}
+	return nil
+}
+
+func (m *DeliveryAck) GetTrace() []*RelayTraceHop {
+	if m != nil {
+		return m.Trace
+	}
+	return nil
+}
+
+type RelayTraceHop struct {
+	NodeId    []byte             `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Timestamp int64              `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Decision  RelayTraceDecision `protobuf:"varint,3,opt,name=decision,proto3,enum=pb.RelayTraceDecision" json:"decision,omitempty"`
+	Reason    string             `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *RelayTraceHop) Reset()      { *m = RelayTraceHop{} }
+func (*RelayTraceHop) ProtoMessage() {}
+func (*RelayTraceHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_clientmessage_e75ddb01f33e1ea8, []int{5}
+}
+func (m *RelayTraceHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayTraceHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayTraceHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RelayTraceHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayTraceHop.Merge(dst, src)
+}
+func (m *RelayTraceHop) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayTraceHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayTraceHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayTraceHop proto.InternalMessageInfo
+
+func (m *RelayTraceHop) GetNodeId() []byte {
+	if m != nil {
+		return m.NodeId
+	}
+	return nil
+}
+
+func (m *RelayTraceHop) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *RelayTraceHop) GetDecision() RelayTraceDecision {
+	if m != nil {
+		return m.Decision
+	}
+	return TRACE_FORWARDED
+}
+
+func (m *RelayTraceHop) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ClientMessage)(nil), "pb.ClientMessage")
 	proto.RegisterType((*OutboundMessage)(nil), "pb.OutboundMessage")
 	proto.RegisterType((*InboundMessage)(nil), "pb.InboundMessage")
 	proto.RegisterType((*Receipt)(nil), "pb.Receipt")
+	proto.RegisterType((*DeliveryAck)(nil), "pb.DeliveryAck")
+	proto.RegisterType((*RelayTraceHop)(nil), "pb.RelayTraceHop")
 	proto.RegisterEnum("pb.ClientMessageType", ClientMessageType_name, ClientMessageType_value)
+	proto.RegisterEnum("pb.DeliveryStatus", DeliveryStatus_name, DeliveryStatus_value)
+	proto.RegisterEnum("pb.RelayTraceDecision", RelayTraceDecision_name, RelayTraceDecision_value)
 }
 func (x ClientMessageType) String() string {
 	s, ok := ClientMessageType_name[int32(x)]
@@ -317,6 +569,20 @@ func (x ClientMessageType) String() string {
 	}
 	return strconv.Itoa(int(x))
 }
+func (x DeliveryStatus) String() string {
+	s, ok := DeliveryStatus_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RelayTraceDecision) String() string {
+	s, ok := RelayTraceDecision_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
 func (this *ClientMessage) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -394,6 +660,18 @@ func (this *OutboundMessage) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Topic != that1.Topic {
+		return false
+	}
+	if this.RequestAck != that1.RequestAck {
+		return false
+	}
+	if !bytes.Equal(this.MessageId, that1.MessageId) {
+		return false
+	}
+	if this.Trace != that1.Trace {
+		return false
+	}
 	return true
 }
 func (this *InboundMessage) Equal(that interface{}) bool {
@@ -453,6 +731,91 @@ func (this *Receipt) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DeliveryAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeliveryAck)
+	if !ok {
+		that2, ok := that.(DeliveryAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.MessageId, that1.MessageId) {
+		return false
+	}
+	if !bytes.Equal(this.DestId, that1.DestId) {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if !bytes.Equal(this.NodePubkey, that1.NodePubkey) {
+		return false
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	if len(this.MissingFragments) != len(that1.MissingFragments) {
+		return false
+	}
+	for i := range this.MissingFragments {
+		if this.MissingFragments[i] != that1.MissingFragments[i] {
+			return false
+		}
+	}
+	if len(this.Trace) != len(that1.Trace) {
+		return false
+	}
+	for i := range this.Trace {
+		if !this.Trace[i].Equal(that1.Trace[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RelayTraceHop) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RelayTraceHop)
+	if !ok {
+		that2, ok := that.(RelayTraceHop)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.NodeId, that1.NodeId) {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.Decision != that1.Decision {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
+}
 func (this *ClientMessage) GoString() string {
 	if this == nil {
 		return "nil"
@@ -468,7 +831,7 @@ func (this *OutboundMessage) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 15)
 	s = append(s, "&pb.OutboundMessage{")
 	s = append(s, "Dest: "+fmt.Sprintf("%#v", this.Dest)+",\n")
 	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
@@ -477,6 +840,10 @@ func (this *OutboundMessage) GoString() string {
 	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
 	s = append(s, "BlockHash: "+fmt.Sprintf("%#v", this.BlockHash)+",\n")
 	s = append(s, "Signatures: "+fmt.Sprintf("%#v", this.Signatures)+",\n")
+	s = append(s, "Topic: "+fmt.Sprintf("%#v", this.Topic)+",\n")
+	s = append(s, "RequestAck: "+fmt.Sprintf("%#v", this.RequestAck)+",\n")
+	s = append(s, "MessageId: "+fmt.Sprintf("%#v", this.MessageId)+",\n")
+	s = append(s, "Trace: "+fmt.Sprintf("%#v", this.Trace)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -503,6 +870,37 @@ func (this *Receipt) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *DeliveryAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&pb.DeliveryAck{")
+	s = append(s, "MessageId: "+fmt.Sprintf("%#v", this.MessageId)+",\n")
+	s = append(s, "DestId: "+fmt.Sprintf("%#v", this.DestId)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "NodePubkey: "+fmt.Sprintf("%#v", this.NodePubkey)+",\n")
+	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "MissingFragments: "+fmt.Sprintf("%#v", this.MissingFragments)+",\n")
+	if this.Trace != nil {
+		s = append(s, "Trace: "+fmt.Sprintf("%#v", this.Trace)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RelayTraceHop) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&pb.RelayTraceHop{")
+	s = append(s, "NodeId: "+fmt.Sprintf("%#v", this.NodeId)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "Decision: "+fmt.Sprintf("%#v", this.Decision)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringClientmessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -606,6 +1004,38 @@ func (m *OutboundMessage) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], b)
 		}
 	}
+	if len(m.Topic) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintClientmessage(dAtA, i, uint64(len(m.Topic)))
+		i += copy(dAtA[i:], m.Topic)
+	}
+	if m.RequestAck {
+		dAtA[i] = 0x48
+		i++
+		if m.RequestAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.MessageId) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintClientmessage(dAtA, i, uint64(len(m.MessageId)))
+		i += copy(dAtA[i:], m.MessageId)
+	}
+	if m.Trace {
+		dAtA[i] = 0x58
+		i++
+		if m.Trace {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -675,18 +1105,134 @@ func (m *Receipt) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintClientmessage(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DeliveryAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
+	return dAtA[:n], nil
+}
+
+func (m *DeliveryAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintClientmessage(dAtA, i, uint64(len(m.MessageId)))
+		i += copy(dAtA[i:], m.MessageId)
+	}
+	if len(m.DestId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintClientmessage(dAtA, i, uint64(len(m.DestId)))
+		i += copy(dAtA[i:], m.DestId)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintClientmessage(dAtA, i, uint64(m.Status))
+	}
+	if len(m.NodePubkey) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintClientmessage(dAtA, i, uint64(len(m.NodePubkey)))
+		i += copy(dAtA[i:], m.NodePubkey)
+	}
+	if len(m.Signature) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintClientmessage(dAtA, i, uint64(len(m.Signature)))
+		i += copy(dAtA[i:], m.Signature)
+	}
+	if len(m.MissingFragments) > 0 {
+		dAtA2 := make([]byte, len(m.MissingFragments)*10)
+		var j1 int
+		for _, num := range m.MissingFragments {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintClientmessage(dAtA, i, uint64(j1))
+		i += copy(dAtA[i:], dAtA2[:j1])
+	}
+	if len(m.Trace) > 0 {
+		for _, msg := range m.Trace {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintClientmessage(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *RelayTraceHop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayTraceHop) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintClientmessage(dAtA, i, uint64(len(m.NodeId)))
+		i += copy(dAtA[i:], m.NodeId)
+	}
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintClientmessage(dAtA, i, uint64(m.Timestamp))
+	}
+	if m.Decision != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintClientmessage(dAtA, i, uint64(m.Decision))
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintClientmessage(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	return i, nil
+}
+
+func encodeVarintClientmessage(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return offset + 1
 }
 func NewPopulatedClientMessage(r randyClientmessage, easy bool) *ClientMessage {
 	this := &ClientMessage{}
-	this.MessageType = ClientMessageType([]int32{0, 1, 2}[r.Intn(3)])
+	this.MessageType = ClientMessageType([]int32{0, 1, 2, 3}[r.Intn(4)])
 	v1 := r.Intn(100)
 	this.Message = make([]byte, v1)
 	for i := 0; i < v1; i++ {
@@ -726,6 +1272,14 @@ func NewPopulatedOutboundMessage(r randyClientmessage, easy bool) *OutboundMessa
 			this.Signatures[i][j] = byte(r.Intn(256))
 		}
 	}
+	this.Topic = string(randStringClientmessage(r))
+	this.RequestAck = bool(bool(r.Intn(2) == 0))
+	v7 := r.Intn(100)
+	this.MessageId = make([]byte, v7)
+	for i := 0; i < v7; i++ {
+		this.MessageId[i] = byte(r.Intn(256))
+	}
+	this.Trace = bool(bool(r.Intn(2) == 0))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -734,14 +1288,14 @@ func NewPopulatedOutboundMessage(r randyClientmessage, easy bool) *OutboundMessa
 func NewPopulatedInboundMessage(r randyClientmessage, easy bool) *InboundMessage {
 	this := &InboundMessage{}
 	this.Src = string(randStringClientmessage(r))
-	v7 := r.Intn(100)
-	this.Payload = make([]byte, v7)
-	for i := 0; i < v7; i++ {
-		this.Payload[i] = byte(r.Intn(256))
-	}
 	v8 := r.Intn(100)
-	this.PrevSignature = make([]byte, v8)
+	this.Payload = make([]byte, v8)
 	for i := 0; i < v8; i++ {
+		this.Payload[i] = byte(r.Intn(256))
+	}
+	v9 := r.Intn(100)
+	this.PrevSignature = make([]byte, v9)
+	for i := 0; i < v9; i++ {
 		this.PrevSignature[i] = byte(r.Intn(256))
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -751,16 +1305,74 @@ func NewPopulatedInboundMessage(r randyClientmessage, easy bool) *InboundMessage
 
 func NewPopulatedReceipt(r randyClientmessage, easy bool) *Receipt {
 	this := &Receipt{}
-	v9 := r.Intn(100)
-	this.PrevSignature = make([]byte, v9)
-	for i := 0; i < v9; i++ {
-		this.PrevSignature[i] = byte(r.Intn(256))
-	}
 	v10 := r.Intn(100)
-	this.Signature = make([]byte, v10)
+	this.PrevSignature = make([]byte, v10)
 	for i := 0; i < v10; i++ {
+		this.PrevSignature[i] = byte(r.Intn(256))
+	}
+	v11 := r.Intn(100)
+	this.Signature = make([]byte, v11)
+	for i := 0; i < v11; i++ {
+		this.Signature[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDeliveryAck(r randyClientmessage, easy bool) *DeliveryAck {
+	this := &DeliveryAck{}
+	v12 := r.Intn(100)
+	this.MessageId = make([]byte, v12)
+	for i := 0; i < v12; i++ {
+		this.MessageId[i] = byte(r.Intn(256))
+	}
+	v13 := r.Intn(100)
+	this.DestId = make([]byte, v13)
+	for i := 0; i < v13; i++ {
+		this.DestId[i] = byte(r.Intn(256))
+	}
+	this.Status = DeliveryStatus([]int32{0, 1, 2, 3}[r.Intn(4)])
+	v14 := r.Intn(100)
+	this.NodePubkey = make([]byte, v14)
+	for i := 0; i < v14; i++ {
+		this.NodePubkey[i] = byte(r.Intn(256))
+	}
+	v15 := r.Intn(100)
+	this.Signature = make([]byte, v15)
+	for i := 0; i < v15; i++ {
 		this.Signature[i] = byte(r.Intn(256))
 	}
+	v16 := r.Intn(10)
+	this.MissingFragments = make([]uint32, v16)
+	for i := 0; i < v16; i++ {
+		this.MissingFragments[i] = uint32(r.Uint32())
+	}
+	if r.Intn(10) != 0 {
+		v17 := r.Intn(5)
+		this.Trace = make([]*RelayTraceHop, v17)
+		for i := 0; i < v17; i++ {
+			this.Trace[i] = NewPopulatedRelayTraceHop(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedRelayTraceHop(r randyClientmessage, easy bool) *RelayTraceHop {
+	this := &RelayTraceHop{}
+	v18 := r.Intn(100)
+	this.NodeId = make([]byte, v18)
+	for i := 0; i < v18; i++ {
+		this.NodeId[i] = byte(r.Intn(256))
+	}
+	this.Timestamp = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Timestamp *= -1
+	}
+	this.Decision = RelayTraceDecision([]int32{0, 1, 2, 3}[r.Intn(4)])
+	this.Reason = string(randStringClientmessage(r))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -785,9 +1397,9 @@ func randUTF8RuneClientmessage(r randyClientmessage) rune {
 	return rune(ru + 61)
 }
 func randStringClientmessage(r randyClientmessage) string {
-	v11 := r.Intn(100)
-	tmps := make([]rune, v11)
-	for i := 0; i < v11; i++ {
+	v19 := r.Intn(100)
+	tmps := make([]rune, v19)
+	for i := 0; i < v19; i++ {
 		tmps[i] = randUTF8RuneClientmessage(r)
 	}
 	return string(tmps)
@@ -809,11 +1421,11 @@ func randFieldClientmessage(dAtA []byte, r randyClientmessage, fieldNumber int,
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateClientmessage(dAtA, uint64(key))
-		v12 := r.Int63()
+		v20 := r.Int63()
 		if r.Intn(2) == 0 {
-			v12 *= -1
+			v20 *= -1
 		}
-		dAtA = encodeVarintPopulateClientmessage(dAtA, uint64(v12))
+		dAtA = encodeVarintPopulateClientmessage(dAtA, uint64(v20))
 	case 1:
 		dAtA = encodeVarintPopulateClientmessage(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -890,6 +1502,20 @@ func (m *OutboundMessage) Size() (n int) {
 			n += 1 + l + sovClientmessage(uint64(l))
 		}
 	}
+	l = len(m.Topic)
+	if l > 0 {
+		n += 1 + l + sovClientmessage(uint64(l))
+	}
+	if m.RequestAck {
+		n += 2
+	}
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovClientmessage(uint64(l))
+	}
+	if m.Trace {
+		n += 2
+	}
 	return n
 }
 
@@ -931,6 +1557,70 @@ func (m *Receipt) Size() (n int) {
 	return n
 }
 
+func (m *DeliveryAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovClientmessage(uint64(l))
+	}
+	l = len(m.DestId)
+	if l > 0 {
+		n += 1 + l + sovClientmessage(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovClientmessage(uint64(m.Status))
+	}
+	l = len(m.NodePubkey)
+	if l > 0 {
+		n += 1 + l + sovClientmessage(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovClientmessage(uint64(l))
+	}
+	if len(m.MissingFragments) > 0 {
+		l = 0
+		for _, e := range m.MissingFragments {
+			l += sovClientmessage(uint64(e))
+		}
+		n += 1 + sovClientmessage(uint64(l)) + l
+	}
+	if len(m.Trace) > 0 {
+		for _, e := range m.Trace {
+			l = e.Size()
+			n += 1 + l + sovClientmessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RelayTraceHop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovClientmessage(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovClientmessage(uint64(m.Timestamp))
+	}
+	if m.Decision != 0 {
+		n += 1 + sovClientmessage(uint64(m.Decision))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovClientmessage(uint64(l))
+	}
+	return n
+}
+
 func sovClientmessage(x uint64) (n int) {
 	for {
 		n++
@@ -967,6 +1657,10 @@ func (this *OutboundMessage) String() string {
 		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
 		`BlockHash:` + fmt.Sprintf("%v", this.BlockHash) + `,`,
 		`Signatures:` + fmt.Sprintf("%v", this.Signatures) + `,`,
+		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
+		`RequestAck:` + fmt.Sprintf("%v", this.RequestAck) + `,`,
+		`MessageId:` + fmt.Sprintf("%v", this.MessageId) + `,`,
+		`Trace:` + fmt.Sprintf("%v", this.Trace) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -994,6 +1688,35 @@ func (this *Receipt) String() string {
 	}, "")
 	return s
 }
+func (this *DeliveryAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeliveryAck{`,
+		`MessageId:` + fmt.Sprintf("%v", this.MessageId) + `,`,
+		`DestId:` + fmt.Sprintf("%v", this.DestId) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`NodePubkey:` + fmt.Sprintf("%v", this.NodePubkey) + `,`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`MissingFragments:` + fmt.Sprintf("%v", this.MissingFragments) + `,`,
+		`Trace:` + strings.Replace(fmt.Sprintf("%v", this.Trace), "RelayTraceHop", "RelayTraceHop", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RelayTraceHop) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelayTraceHop{`,
+		`NodeId:` + fmt.Sprintf("%v", this.NodeId) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`Decision:` + fmt.Sprintf("%v", this.Decision) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringClientmessage(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1318,59 +2041,9 @@ func (m *OutboundMessage) Unmarshal(dAtA []byte) error {
 			m.Signatures = append(m.Signatures, make([]byte, postIndex-iNdEx))
 			copy(m.Signatures[len(m.Signatures)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipClientmessage(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthClientmessage
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InboundMessage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowClientmessage
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InboundMessage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InboundMessage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Src", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1395,13 +2068,13 @@ func (m *InboundMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Src = string(dAtA[iNdEx:postIndex])
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestAck", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClientmessage
@@ -1411,26 +2084,15 @@ func (m *InboundMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthClientmessage
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
+			m.RequestAck = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1454,15 +2116,176 @@ func (m *InboundMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrevSignature = append(m.PrevSignature[:0], dAtA[iNdEx:postIndex]...)
-			if m.PrevSignature == nil {
-				m.PrevSignature = []byte{}
+			m.MessageId = append(m.MessageId[:0], dAtA[iNdEx:postIndex]...)
+			if m.MessageId == nil {
+				m.MessageId = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipClientmessage(dAtA[iNdEx:])
-			if err != nil {
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Trace = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClientmessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClientmessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InboundMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClientmessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InboundMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InboundMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Src", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClientmessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Src = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClientmessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClientmessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevSignature = append(m.PrevSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrevSignature == nil {
+				m.PrevSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClientmessage(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
 			if skippy < 0 {
@@ -1592,6 +2415,451 @@ func (m *Receipt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DeliveryAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClientmessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeliveryAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeliveryAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClientmessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageId = append(m.MessageId[:0], dAtA[iNdEx:postIndex]...)
+			if m.MessageId == nil {
+				m.MessageId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClientmessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestId = append(m.DestId[:0], dAtA[iNdEx:postIndex]...)
+			if m.DestId == nil {
+				m.DestId = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (DeliveryStatus(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodePubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClientmessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodePubkey = append(m.NodePubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodePubkey == nil {
+				m.NodePubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClientmessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClientmessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MissingFragments = append(m.MissingFragments, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClientmessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClientmessage
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MissingFragments) == 0 {
+					m.MissingFragments = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClientmessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MissingFragments = append(m.MissingFragments, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingFragments", wireType)
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClientmessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trace = append(m.Trace, &RelayTraceHop{})
+			if err := m.Trace[len(m.Trace)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClientmessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClientmessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayTraceHop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClientmessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayTraceHop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayTraceHop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClientmessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = append(m.NodeId[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodeId == nil {
+				m.NodeId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
+			}
+			m.Decision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decision |= (RelayTraceDecision(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientmessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClientmessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClientmessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClientmessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipClientmessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1698,39 +2966,60 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("pb/clientmessage.proto", fileDescriptor_clientmessage_c021a6273ba926f1)
-}
-
-var fileDescriptor_clientmessage_c021a6273ba926f1 = []byte{
-	// 468 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xbd, 0x71, 0xdb, 0x28, 0xd3, 0x24, 0x4d, 0xb7, 0x05, 0x59, 0x08, 0x56, 0x56, 0x24,
-	0x24, 0x0b, 0x09, 0x47, 0x82, 0x0b, 0x07, 0x2e, 0xb4, 0x44, 0x34, 0x42, 0x4d, 0x90, 0x93, 0x9e,
-	0x2d, 0x7b, 0xbd, 0xd8, 0x16, 0xb1, 0x77, 0x95, 0xb5, 0x51, 0x73, 0xe3, 0x11, 0x78, 0x0c, 0x1e,
-	0x81, 0x47, 0xe0, 0x98, 0x63, 0xc5, 0x89, 0x38, 0x17, 0x8e, 0x3d, 0x72, 0x44, 0x5e, 0x27, 0x84,
-	0x88, 0x8a, 0xdb, 0xfc, 0xff, 0xfc, 0xf3, 0x8d, 0x46, 0xbb, 0x70, 0x5f, 0xf8, 0x3d, 0x3a, 0x8d,
-	0x59, 0x9a, 0x25, 0x4c, 0x4a, 0x2f, 0x64, 0xb6, 0x98, 0xf1, 0x8c, 0xe3, 0x9a, 0xf0, 0x1f, 0x3c,
-	0x0d, 0xe3, 0x2c, 0xca, 0x7d, 0x9b, 0xf2, 0xa4, 0x17, 0xf2, 0x90, 0xf7, 0x54, 0xcb, 0xcf, 0xdf,
-	0x2b, 0xa5, 0x84, 0xaa, 0xaa, 0x91, 0x2e, 0x85, 0xd6, 0xb9, 0x22, 0x5d, 0x56, 0x24, 0xfc, 0x02,
-	0x9a, 0x6b, 0xa8, 0x9b, 0xcd, 0x05, 0x33, 0x90, 0x89, 0xac, 0xf6, 0xb3, 0x7b, 0xb6, 0xf0, 0xed,
-	0x9d, 0xe0, 0x64, 0x2e, 0x98, 0x73, 0x98, 0x6c, 0x05, 0x36, 0xa0, 0xbe, 0x96, 0x46, 0xcd, 0x44,
-	0x56, 0xd3, 0xd9, 0xc8, 0xee, 0x77, 0x04, 0x47, 0xa3, 0x3c, 0xf3, 0x79, 0x9e, 0x06, 0x9b, 0x3d,
-	0x18, 0xf6, 0x02, 0x26, 0x33, 0xc5, 0x6f, 0x38, 0xaa, 0x2e, 0x09, 0xc2, 0x9b, 0x4f, 0xb9, 0x17,
-	0x6c, 0x08, 0x6b, 0x89, 0x4f, 0x61, 0xbf, 0x4c, 0x48, 0x43, 0x37, 0x75, 0xab, 0xe1, 0x54, 0x02,
-	0xdb, 0x70, 0x92, 0x78, 0xd7, 0x6e, 0xc4, 0xa7, 0x41, 0x9c, 0x86, 0xae, 0x64, 0x94, 0xa7, 0x81,
-	0x34, 0xf6, 0x4c, 0x64, 0xb5, 0x9c, 0xe3, 0xc4, 0xbb, 0xbe, 0xa8, 0x3a, 0xe3, 0xaa, 0x51, 0x52,
-	0x52, 0x9e, 0x52, 0x66, 0xec, 0xab, 0x44, 0x25, 0xf0, 0x23, 0x00, 0x7f, 0xca, 0xe9, 0x07, 0x37,
-	0xf2, 0x64, 0x64, 0x1c, 0xa8, 0xc5, 0x0d, 0xe5, 0x5c, 0x78, 0x32, 0xc2, 0x04, 0x40, 0xc6, 0x61,
-	0xea, 0x65, 0xf9, 0x8c, 0x49, 0xa3, 0x6e, 0xea, 0x56, 0xd3, 0xf9, 0xcb, 0xe9, 0x52, 0x68, 0x0f,
-	0xd2, 0x9d, 0xd3, 0x3a, 0xa0, 0xcb, 0x19, 0x5d, 0x5f, 0x56, 0x96, 0xff, 0x39, 0xec, 0x31, 0xb4,
-	0xc5, 0x8c, 0x7d, 0x74, 0xff, 0x00, 0x0d, 0x5d, 0x05, 0x5a, 0xa5, 0x3b, 0xde, 0x98, 0xdd, 0x21,
-	0xd4, 0x1d, 0x46, 0x59, 0x2c, 0xb2, 0x3b, 0x26, 0xd0, 0x1d, 0x13, 0xf8, 0x21, 0x34, 0xb6, 0x89,
-	0x6a, 0xe9, 0xd6, 0x78, 0xf2, 0x16, 0x8e, 0xff, 0x79, 0x4d, 0x7c, 0x0a, 0x9d, 0xd1, 0xd5, 0xe4,
-	0x6c, 0x74, 0x35, 0x7c, 0xed, 0x5e, 0xf6, 0xc7, 0xe3, 0x57, 0x6f, 0xfa, 0x1d, 0x0d, 0x9f, 0xc0,
-	0xd1, 0x60, 0xb8, 0x6b, 0x22, 0x7c, 0x08, 0x75, 0xa7, 0x7f, 0xde, 0x1f, 0xbc, 0x9b, 0x74, 0x6a,
-	0x67, 0x2f, 0x17, 0x4b, 0xa2, 0xdd, 0x2c, 0x89, 0x76, 0xbb, 0x24, 0xe8, 0xd7, 0x92, 0xa0, 0x4f,
-	0x05, 0x41, 0x5f, 0x0a, 0x82, 0xbe, 0x16, 0x04, 0x7d, 0x2b, 0x08, 0x5a, 0x14, 0x04, 0xfd, 0x28,
-	0x08, 0xfa, 0x59, 0x10, 0xed, 0xb6, 0x20, 0xe8, 0xf3, 0x8a, 0x68, 0x8b, 0x15, 0xd1, 0x6e, 0x56,
-	0x44, 0xf3, 0x0f, 0xd4, 0x47, 0x7c, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x1d, 0xef, 0xdc, 0x73,
-	0xd5, 0x02, 0x00, 0x00,
+	proto.RegisterFile("pb/clientmessage.proto", fileDescriptor_clientmessage_e75ddb01f33e1ea8)
+}
+
+var fileDescriptor_clientmessage_e75ddb01f33e1ea8 = []byte{
+	// 812 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xce, 0xc4, 0xdb, 0xa4, 0x79, 0xf9, 0xb1, 0xce, 0xec, 0x52, 0x2c, 0x04, 0x26, 0x8a, 0x84,
+	0x88, 0x8a, 0x48, 0xa5, 0x72, 0xe1, 0xc0, 0x25, 0x4d, 0x5c, 0x1a, 0xd8, 0x36, 0xd1, 0x24, 0x05,
+	0x71, 0x40, 0x96, 0x7f, 0xcc, 0x26, 0x56, 0x63, 0x8f, 0xf1, 0x4c, 0x56, 0x9b, 0x1b, 0x27, 0xce,
+	0x88, 0xbf, 0x82, 0x3f, 0x81, 0xbf, 0x00, 0x71, 0xec, 0x71, 0x8f, 0xd4, 0xbd, 0x70, 0xdc, 0x23,
+	0x47, 0x34, 0x63, 0x3b, 0x21, 0xdb, 0xd5, 0xde, 0xfc, 0x7d, 0xef, 0xcd, 0xf7, 0xbd, 0xf7, 0xcd,
+	0x24, 0x70, 0x14, 0xbb, 0x27, 0xde, 0x2a, 0xa0, 0x91, 0x08, 0x29, 0xe7, 0xce, 0x82, 0xf6, 0xe3,
+	0x84, 0x09, 0x86, 0xcb, 0xb1, 0xfb, 0xc1, 0xe7, 0x8b, 0x40, 0x2c, 0xd7, 0x6e, 0xdf, 0x63, 0xe1,
+	0xc9, 0x82, 0x2d, 0xd8, 0x89, 0x2a, 0xb9, 0xeb, 0xe7, 0x0a, 0x29, 0xa0, 0xbe, 0xb2, 0x23, 0x5d,
+	0x0f, 0x9a, 0x43, 0xa5, 0x74, 0x99, 0x29, 0xe1, 0x2f, 0xa1, 0x91, 0x8b, 0xda, 0x62, 0x13, 0x53,
+	0x03, 0x75, 0x50, 0xaf, 0x75, 0xfa, 0x5e, 0x3f, 0x76, 0xfb, 0x7b, 0x8d, 0xf3, 0x4d, 0x4c, 0x49,
+	0x3d, 0xdc, 0x01, 0x6c, 0x40, 0x35, 0x87, 0x46, 0xb9, 0x83, 0x7a, 0x0d, 0x52, 0xc0, 0xee, 0x9f,
+	0x65, 0x78, 0x3c, 0x59, 0x0b, 0x97, 0xad, 0x23, 0xbf, 0xf0, 0xc1, 0xf0, 0xc8, 0xa7, 0x5c, 0x28,
+	0xfd, 0x1a, 0x51, 0xdf, 0x52, 0x21, 0x76, 0x36, 0x2b, 0xe6, 0xf8, 0x85, 0x42, 0x0e, 0xf1, 0x53,
+	0x38, 0x90, 0x1d, 0xdc, 0xd0, 0x3a, 0x5a, 0xaf, 0x46, 0x32, 0x80, 0xfb, 0xf0, 0x24, 0x74, 0x5e,
+	0xda, 0x4b, 0xb6, 0xf2, 0x83, 0x68, 0x61, 0x73, 0xea, 0xb1, 0xc8, 0xe7, 0xc6, 0xa3, 0x0e, 0xea,
+	0x35, 0x49, 0x3b, 0x74, 0x5e, 0x5e, 0x64, 0x95, 0x59, 0x56, 0x90, 0x2a, 0x11, 0x8b, 0x3c, 0x6a,
+	0x1c, 0xa8, 0x8e, 0x0c, 0xe0, 0x8f, 0x00, 0xdc, 0x15, 0xf3, 0x6e, 0xec, 0xa5, 0xc3, 0x97, 0x46,
+	0x45, 0x19, 0xd7, 0x14, 0x73, 0xe1, 0xf0, 0x25, 0x36, 0x01, 0x78, 0xb0, 0x88, 0x1c, 0xb1, 0x4e,
+	0x28, 0x37, 0xaa, 0x1d, 0xad, 0xd7, 0x20, 0xff, 0x63, 0xa4, 0xa8, 0x60, 0x71, 0xe0, 0x19, 0x87,
+	0x6a, 0x93, 0x0c, 0xe0, 0x8f, 0xa1, 0x9e, 0xd0, 0x9f, 0xd6, 0x94, 0x0b, 0xdb, 0xf1, 0x6e, 0x8c,
+	0x5a, 0x07, 0xf5, 0x0e, 0x09, 0xe4, 0xd4, 0xc0, 0xbb, 0x91, 0xae, 0x45, 0xce, 0x81, 0x6f, 0x40,
+	0xe6, 0x9a, 0x33, 0x63, 0xb5, 0xb0, 0x48, 0x1c, 0x8f, 0x1a, 0x75, 0x75, 0x32, 0x03, 0x5d, 0x0f,
+	0x5a, 0xe3, 0x68, 0x2f, 0x46, 0x1d, 0x34, 0x9e, 0x78, 0x79, 0x8a, 0xf2, 0xf3, 0x1d, 0x21, 0x7e,
+	0x02, 0xad, 0x38, 0xa1, 0x2f, 0xec, 0xed, 0xf0, 0x86, 0xa6, 0x1a, 0x9a, 0x92, 0x9d, 0x15, 0x64,
+	0xf7, 0x0a, 0xaa, 0x84, 0x7a, 0x34, 0x88, 0xc5, 0x5b, 0x4e, 0xa0, 0xb7, 0x9c, 0xc0, 0x1f, 0x42,
+	0x6d, 0xd7, 0x91, 0x99, 0xee, 0x88, 0xee, 0x2f, 0x65, 0xa8, 0x8f, 0xe8, 0x2a, 0x78, 0x41, 0x93,
+	0xcd, 0xc3, 0xcd, 0xd1, 0x9b, 0x9b, 0xbf, 0x0f, 0x55, 0x79, 0xbb, 0xb2, 0x96, 0x49, 0x55, 0x24,
+	0x1c, 0xfb, 0xf8, 0x18, 0x2a, 0x5c, 0x38, 0x62, 0xcd, 0xd5, 0xd8, 0xad, 0x53, 0x2c, 0xdf, 0x64,
+	0x21, 0x3c, 0x53, 0x15, 0x92, 0x77, 0xc8, 0xf8, 0x23, 0xe6, 0x53, 0x3b, 0x5e, 0xbb, 0x37, 0x74,
+	0xa3, 0x5e, 0x44, 0x83, 0x80, 0xa4, 0xa6, 0x8a, 0xd9, 0x1f, 0xf9, 0xe0, 0x8d, 0x91, 0xf1, 0x67,
+	0xd0, 0x0e, 0x03, 0xce, 0xe5, 0xa3, 0x7a, 0x9e, 0x38, 0x8b, 0x90, 0x46, 0x82, 0x1b, 0x95, 0x8e,
+	0xd6, 0x6b, 0x12, 0x3d, 0x2f, 0x9c, 0x17, 0x3c, 0xfe, 0xb4, 0xb8, 0x2a, 0xf9, 0x36, 0xea, 0xa7,
+	0x6d, 0x39, 0x16, 0xa1, 0x2b, 0x67, 0x33, 0x97, 0xec, 0x05, 0x8b, 0x8b, 0xdb, 0xfb, 0x0d, 0x41,
+	0x73, 0xaf, 0x20, 0x77, 0x55, 0x63, 0x6e, 0x73, 0xa8, 0x48, 0x38, 0xf6, 0xe5, 0x78, 0x22, 0x08,
+	0x29, 0x17, 0x4e, 0x18, 0xab, 0x18, 0x34, 0xb2, 0x23, 0xf0, 0x29, 0x1c, 0xfa, 0xd4, 0x0b, 0x78,
+	0xc0, 0xa2, 0x3c, 0x8b, 0xa3, 0x7d, 0xd3, 0x51, 0x5e, 0x25, 0xdb, 0x3e, 0x7c, 0x04, 0x95, 0x84,
+	0x3a, 0x9c, 0x45, 0x2a, 0x8c, 0x1a, 0xc9, 0xd1, 0xf1, 0x8f, 0xd0, 0x7e, 0xf0, 0xbb, 0xc6, 0x4f,
+	0x41, 0x9f, 0x5c, 0xcf, 0xcf, 0x26, 0xd7, 0x57, 0x23, 0xfb, 0xd2, 0x9a, 0xcd, 0x06, 0x5f, 0x5b,
+	0x7a, 0x09, 0x3f, 0x81, 0xc7, 0xe3, 0xab, 0x7d, 0x12, 0xe1, 0x3a, 0x54, 0x89, 0x35, 0xb4, 0xc6,
+	0xd3, 0xb9, 0x5e, 0xc6, 0x3a, 0x34, 0x46, 0xd6, 0xb3, 0xf1, 0x77, 0x16, 0xf9, 0xc1, 0x1e, 0x0c,
+	0xbf, 0xd5, 0xb5, 0xe3, 0x6f, 0xa0, 0xb5, 0x7f, 0x45, 0xb8, 0x09, 0xb5, 0xbc, 0xc7, 0x1a, 0xe9,
+	0x25, 0xdc, 0x80, 0xc3, 0xb3, 0xeb, 0xf3, 0x73, 0x85, 0x94, 0xda, 0x88, 0x4c, 0xa6, 0x53, 0x6b,
+	0xa4, 0x97, 0x71, 0x0b, 0x60, 0x7c, 0x35, 0x9c, 0x5c, 0x4e, 0x9f, 0x59, 0x73, 0x4b, 0xd7, 0x8e,
+	0x29, 0xe0, 0x87, 0x2b, 0xca, 0xa9, 0xe6, 0x64, 0x30, 0xb4, 0xec, 0xf3, 0x09, 0xf9, 0x7e, 0x40,
+	0x46, 0x4a, 0x75, 0x4b, 0xee, 0xac, 0x10, 0xc6, 0xd0, 0xca, 0xc8, 0xad, 0x61, 0x19, 0xb7, 0xa1,
+	0x99, 0x37, 0xe6, 0xb6, 0xda, 0xd9, 0x57, 0xb7, 0x77, 0x66, 0xe9, 0xd5, 0x9d, 0x59, 0x7a, 0x7d,
+	0x67, 0xa2, 0x7f, 0xef, 0x4c, 0xf4, 0x73, 0x6a, 0xa2, 0xdf, 0x53, 0x13, 0xfd, 0x91, 0x9a, 0xe8,
+	0xaf, 0xd4, 0x44, 0xb7, 0xa9, 0x89, 0xfe, 0x4e, 0x4d, 0xf4, 0x4f, 0x6a, 0x96, 0x5e, 0xa7, 0x26,
+	0xfa, 0xf5, 0xde, 0x2c, 0xdd, 0xde, 0x9b, 0xa5, 0x57, 0xf7, 0x66, 0xc9, 0xad, 0xa8, 0xff, 0xd5,
+	0x2f, 0xfe, 0x1b, 0x00, 0x21, 0xb6, 0x06, 0xdc, 0xa4, 0x05, 0x00, 0x00,
 }
@@ -0,0 +1,58 @@
+package pb
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+
+	"github.com/nknorg/nkn/common/serialization"
+)
+
+// SerializationUnsigned writes delivery ack fields that are covered by
+// signature. Each field is length prefixed so that different acks can not
+// serialize to the same bytes.
+func (ack *DeliveryAck) SerializationUnsigned(w io.Writer) error {
+	err := serialization.WriteVarBytes(w, ack.MessageId)
+	if err != nil {
+		return err
+	}
+
+	err = serialization.WriteVarBytes(w, ack.DestId)
+	if err != nil {
+		return err
+	}
+
+	err = serialization.WriteUint32(w, uint32(ack.Status))
+	if err != nil {
+		return err
+	}
+
+	err = serialization.WriteVarBytes(w, ack.NodePubkey)
+	if err != nil {
+		return err
+	}
+
+	err = serialization.WriteVarUint(w, uint64(len(ack.MissingFragments)))
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ack.MissingFragments {
+		err = serialization.WriteUint32(w, i)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetHashForSigning returns the hash of delivery ack fields that should be
+// signed by the node that sends the ack.
+func (ack *DeliveryAck) GetHashForSigning() []byte {
+	buf := bytes.NewBuffer(nil)
+	// writing to bytes.Buffer does not return error
+	ack.SerializationUnsigned(buf)
+	hash := sha256.Sum256(buf.Bytes())
+	return hash[:]
+}
@@ -0,0 +1,32 @@
+package pb
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDeliveryAckGetHashForSigning(t *testing.T) {
+	base := &DeliveryAck{MessageId: []byte{1, 2}, DestId: []byte{3}, Status: DELIVERED, NodePubkey: []byte{4}}
+
+	tests := []struct {
+		name string
+		ack  *DeliveryAck
+	}{
+		{"field boundary shifted", &DeliveryAck{MessageId: []byte{1}, DestId: []byte{2, 3}, Status: DELIVERED, NodePubkey: []byte{4}}},
+		{"dest id moved into node pubkey", &DeliveryAck{MessageId: []byte{1, 2}, Status: DELIVERED, NodePubkey: []byte{3, 4}}},
+		{"different status", &DeliveryAck{MessageId: []byte{1, 2}, DestId: []byte{3}, Status: DROPPED, NodePubkey: []byte{4}}},
+		{"missing fragments", &DeliveryAck{MessageId: []byte{1, 2}, DestId: []byte{3}, Status: DELIVERED, NodePubkey: []byte{4}, MissingFragments: []uint32{0}}},
+	}
+
+	for _, test := range tests {
+		if bytes.Equal(base.GetHashForSigning(), test.ack.GetHashForSigning()) {
+			t.Errorf("%s: expect different hash", test.name)
+		}
+	}
+
+	traced := *base
+	traced.Trace = []*RelayTraceHop{{}}
+	if !bytes.Equal(base.GetHashForSigning(), traced.GetHashForSigning()) {
+		t.Errorf("expect trace not covered by hash")
+	}
+}
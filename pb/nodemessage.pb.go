@@ -50,6 +50,9 @@ const (
 	I_HAVE_SIGNATURE_CHAIN_TRANSACTION        MessageType = 16
 	REQUEST_SIGNATURE_CHAIN_TRANSACTION       MessageType = 17
 	REQUEST_SIGNATURE_CHAIN_TRANSACTION_REPLY MessageType = 18
+	GET_STATE_NODES                           MessageType = 19
+	GET_STATE_NODES_REPLY                     MessageType = 20
+	RELAY_DELIVERY_ACK                        MessageType = 21
 )
 
 var MessageType_name = map[int32]string{
@@ -72,6 +75,9 @@ var MessageType_name = map[int32]string{
 	16: "I_HAVE_SIGNATURE_CHAIN_TRANSACTION",
 	17: "REQUEST_SIGNATURE_CHAIN_TRANSACTION",
 	18: "REQUEST_SIGNATURE_CHAIN_TRANSACTION_REPLY",
+	19: "GET_STATE_NODES",
+	20: "GET_STATE_NODES_REPLY",
+	21: "RELAY_DELIVERY_ACK",
 }
 var MessageType_value = map[string]int32{
 	"MESSAGE_TYPE_PLACEHOLDER_DO_NOT_USE": 0,
@@ -93,10 +99,13 @@ var MessageType_value = map[string]int32{
 	"I_HAVE_SIGNATURE_CHAIN_TRANSACTION":        16,
 	"REQUEST_SIGNATURE_CHAIN_TRANSACTION":       17,
 	"REQUEST_SIGNATURE_CHAIN_TRANSACTION_REPLY": 18,
+	"GET_STATE_NODES":                           19,
+	"GET_STATE_NODES_REPLY":                     20,
+	"RELAY_DELIVERY_ACK":                        21,
 }
 
 func (MessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{0}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{0}
 }
 
 // Message type that can be signed message
@@ -115,7 +124,7 @@ var AllowedSignedMessageType_value = map[string]int32{
 }
 
 func (AllowedSignedMessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{1}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{1}
 }
 
 // Message type that can be unsigned message
@@ -142,6 +151,9 @@ const (
 	ALLOW_UNSIGNED_I_HAVE_SIGNATURE_CHAIN_TRANSACTION        AllowedUnsignedMessageType = 16
 	ALLOW_UNSIGNED_REQUEST_SIGNATURE_CHAIN_TRANSACTION       AllowedUnsignedMessageType = 17
 	ALLOW_UNSIGNED_REQUEST_SIGNATURE_CHAIN_TRANSACTION_REPLY AllowedUnsignedMessageType = 18
+	ALLOW_UNSIGNED_GET_STATE_NODES                           AllowedUnsignedMessageType = 19
+	ALLOW_UNSIGNED_GET_STATE_NODES_REPLY                     AllowedUnsignedMessageType = 20
+	ALLOW_UNSIGNED_RELAY_DELIVERY_ACK                        AllowedUnsignedMessageType = 21
 )
 
 var AllowedUnsignedMessageType_name = map[int32]string{
@@ -164,6 +176,9 @@ var AllowedUnsignedMessageType_name = map[int32]string{
 	16: "ALLOW_UNSIGNED_I_HAVE_SIGNATURE_CHAIN_TRANSACTION",
 	17: "ALLOW_UNSIGNED_REQUEST_SIGNATURE_CHAIN_TRANSACTION",
 	18: "ALLOW_UNSIGNED_REQUEST_SIGNATURE_CHAIN_TRANSACTION_REPLY",
+	19: "ALLOW_UNSIGNED_GET_STATE_NODES",
+	20: "ALLOW_UNSIGNED_GET_STATE_NODES_REPLY",
+	21: "ALLOW_UNSIGNED_RELAY_DELIVERY_ACK",
 }
 var AllowedUnsignedMessageType_value = map[string]int32{
 	"ALLOW_UNSIGNED_PLACEHOLDER_DO_NOT_USE":                    0,
@@ -185,10 +200,13 @@ var AllowedUnsignedMessageType_value = map[string]int32{
 	"ALLOW_UNSIGNED_I_HAVE_SIGNATURE_CHAIN_TRANSACTION":        16,
 	"ALLOW_UNSIGNED_REQUEST_SIGNATURE_CHAIN_TRANSACTION":       17,
 	"ALLOW_UNSIGNED_REQUEST_SIGNATURE_CHAIN_TRANSACTION_REPLY": 18,
+	"ALLOW_UNSIGNED_GET_STATE_NODES":                           19,
+	"ALLOW_UNSIGNED_GET_STATE_NODES_REPLY":                     20,
+	"ALLOW_UNSIGNED_RELAY_DELIVERY_ACK":                        21,
 }
 
 func (AllowedUnsignedMessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{2}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{2}
 }
 
 // Message type that can be sent as direct message
@@ -213,6 +231,8 @@ const (
 	ALLOW_DIRECT_I_HAVE_SIGNATURE_CHAIN_TRANSACTION        AllowedDirectMessageType = 16
 	ALLOW_DIRECT_REQUEST_SIGNATURE_CHAIN_TRANSACTION       AllowedDirectMessageType = 17
 	ALLOW_DIRECT_REQUEST_SIGNATURE_CHAIN_TRANSACTION_REPLY AllowedDirectMessageType = 18
+	ALLOW_DIRECT_GET_STATE_NODES                           AllowedDirectMessageType = 19
+	ALLOW_DIRECT_GET_STATE_NODES_REPLY                     AllowedDirectMessageType = 20
 )
 
 var AllowedDirectMessageType_name = map[int32]string{
@@ -233,6 +253,8 @@ var AllowedDirectMessageType_name = map[int32]string{
 	16: "ALLOW_DIRECT_I_HAVE_SIGNATURE_CHAIN_TRANSACTION",
 	17: "ALLOW_DIRECT_REQUEST_SIGNATURE_CHAIN_TRANSACTION",
 	18: "ALLOW_DIRECT_REQUEST_SIGNATURE_CHAIN_TRANSACTION_REPLY",
+	19: "ALLOW_DIRECT_GET_STATE_NODES",
+	20: "ALLOW_DIRECT_GET_STATE_NODES_REPLY",
 }
 var AllowedDirectMessageType_value = map[string]int32{
 	"ALLOW_DIRECT_PLACEHOLDER_DO_NOT_USE":                    0,
@@ -252,10 +274,12 @@ var AllowedDirectMessageType_value = map[string]int32{
 	"ALLOW_DIRECT_I_HAVE_SIGNATURE_CHAIN_TRANSACTION":        16,
 	"ALLOW_DIRECT_REQUEST_SIGNATURE_CHAIN_TRANSACTION":       17,
 	"ALLOW_DIRECT_REQUEST_SIGNATURE_CHAIN_TRANSACTION_REPLY": 18,
+	"ALLOW_DIRECT_GET_STATE_NODES":                           19,
+	"ALLOW_DIRECT_GET_STATE_NODES_REPLY":                     20,
 }
 
 func (AllowedDirectMessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{3}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{3}
 }
 
 // Message type that can be sent as relay message
@@ -265,19 +289,22 @@ type AllowedRelayMessageType int32
 const (
 	ALLOW_RELAY_PLACEHOLDER_DO_NOT_USE AllowedRelayMessageType = 0
 	ALLOW_RELAY_RELAY                  AllowedRelayMessageType = 11
+	ALLOW_RELAY_RELAY_DELIVERY_ACK     AllowedRelayMessageType = 21
 )
 
 var AllowedRelayMessageType_name = map[int32]string{
 	0:  "ALLOW_RELAY_PLACEHOLDER_DO_NOT_USE",
 	11: "ALLOW_RELAY_RELAY",
+	21: "ALLOW_RELAY_RELAY_DELIVERY_ACK",
 }
 var AllowedRelayMessageType_value = map[string]int32{
 	"ALLOW_RELAY_PLACEHOLDER_DO_NOT_USE": 0,
 	"ALLOW_RELAY_RELAY":                  11,
+	"ALLOW_RELAY_RELAY_DELIVERY_ACK":     21,
 }
 
 func (AllowedRelayMessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{4}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{4}
 }
 
 // Message type that can be sent as broadcast_push message
@@ -299,7 +326,7 @@ var AllowedBroadcastPushMessageType_value = map[string]int32{
 }
 
 func (AllowedBroadcastPushMessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{5}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{5}
 }
 
 // Message type that can be sent as broadcast_pull message
@@ -318,7 +345,7 @@ var AllowedBroadcastPullMessageType_value = map[string]int32{
 }
 
 func (AllowedBroadcastPullMessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{6}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{6}
 }
 
 // Message type that can be sent as broadcast_tree message
@@ -340,7 +367,7 @@ var AllowedBroadcastTreeMessageType_value = map[string]int32{
 }
 
 func (AllowedBroadcastTreeMessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{7}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{7}
 }
 
 type RequestTransactionType int32
@@ -363,7 +390,7 @@ var RequestTransactionType_value = map[string]int32{
 }
 
 func (RequestTransactionType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{8}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{8}
 }
 
 type UnsignedMessage struct {
@@ -374,7 +401,7 @@ type UnsignedMessage struct {
 func (m *UnsignedMessage) Reset()      { *m = UnsignedMessage{} }
 func (*UnsignedMessage) ProtoMessage() {}
 func (*UnsignedMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{0}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{0}
 }
 func (m *UnsignedMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,7 +452,7 @@ type SignedMessage struct {
 func (m *SignedMessage) Reset()      { *m = SignedMessage{} }
 func (*SignedMessage) ProtoMessage() {}
 func (*SignedMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{1}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{1}
 }
 func (m *SignedMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -476,7 +503,7 @@ type Vote struct {
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{2}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{2}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,7 +554,7 @@ type IHaveBlockProposal struct {
 func (m *IHaveBlockProposal) Reset()      { *m = IHaveBlockProposal{} }
 func (*IHaveBlockProposal) ProtoMessage() {}
 func (*IHaveBlockProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{3}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{3}
 }
 func (m *IHaveBlockProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,7 +607,7 @@ type RequestBlockProposal struct {
 func (m *RequestBlockProposal) Reset()      { *m = RequestBlockProposal{} }
 func (*RequestBlockProposal) ProtoMessage() {}
 func (*RequestBlockProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{4}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{4}
 }
 func (m *RequestBlockProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -645,7 +672,7 @@ type RequestBlockProposalReply struct {
 func (m *RequestBlockProposalReply) Reset()      { *m = RequestBlockProposalReply{} }
 func (*RequestBlockProposalReply) ProtoMessage() {}
 func (*RequestBlockProposalReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{5}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{5}
 }
 func (m *RequestBlockProposalReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -699,7 +726,7 @@ type RequestProposalTransactions struct {
 func (m *RequestProposalTransactions) Reset()      { *m = RequestProposalTransactions{} }
 func (*RequestProposalTransactions) ProtoMessage() {}
 func (*RequestProposalTransactions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{6}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{6}
 }
 func (m *RequestProposalTransactions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -770,7 +797,7 @@ type RequestProposalTransactionsReply struct {
 func (m *RequestProposalTransactionsReply) Reset()      { *m = RequestProposalTransactionsReply{} }
 func (*RequestProposalTransactionsReply) ProtoMessage() {}
 func (*RequestProposalTransactionsReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{7}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{7}
 }
 func (m *RequestProposalTransactionsReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -812,7 +839,7 @@ type GetConsensusState struct {
 func (m *GetConsensusState) Reset()      { *m = GetConsensusState{} }
 func (*GetConsensusState) ProtoMessage() {}
 func (*GetConsensusState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{8}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{8}
 }
 func (m *GetConsensusState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -852,7 +879,7 @@ type GetConsensusStateReply struct {
 func (m *GetConsensusStateReply) Reset()      { *m = GetConsensusStateReply{} }
 func (*GetConsensusStateReply) ProtoMessage() {}
 func (*GetConsensusStateReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{9}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{9}
 }
 func (m *GetConsensusStateReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +951,7 @@ type GetBlockHeaders struct {
 func (m *GetBlockHeaders) Reset()      { *m = GetBlockHeaders{} }
 func (*GetBlockHeaders) ProtoMessage() {}
 func (*GetBlockHeaders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{10}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{10}
 }
 func (m *GetBlockHeaders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -974,7 +1001,7 @@ type GetBlockHeadersReply struct {
 func (m *GetBlockHeadersReply) Reset()      { *m = GetBlockHeadersReply{} }
 func (*GetBlockHeadersReply) ProtoMessage() {}
 func (*GetBlockHeadersReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{11}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{11}
 }
 func (m *GetBlockHeadersReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1018,7 +1045,7 @@ type GetBlocks struct {
 func (m *GetBlocks) Reset()      { *m = GetBlocks{} }
 func (*GetBlocks) ProtoMessage() {}
 func (*GetBlocks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{12}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{12}
 }
 func (m *GetBlocks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1068,7 +1095,7 @@ type GetBlocksReply struct {
 func (m *GetBlocksReply) Reset()      { *m = GetBlocksReply{} }
 func (*GetBlocksReply) ProtoMessage() {}
 func (*GetBlocksReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{13}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{13}
 }
 func (m *GetBlocksReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1115,12 +1142,23 @@ type Relay struct {
 	BlockHash     []byte `protobuf:"bytes,7,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 	LastSignature []byte `protobuf:"bytes,8,opt,name=last_signature,json=lastSignature,proto3" json:"last_signature,omitempty"`
 	SigChainLen   uint32 `protobuf:"varint,9,opt,name=sig_chain_len,json=sigChainLen,proto3" json:"sig_chain_len,omitempty"`
+	RequestAck    bool   `protobuf:"varint,10,opt,name=request_ack,json=requestAck,proto3" json:"request_ack,omitempty"`
+	MessageId     []byte `protobuf:"bytes,11,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// Large payload is split into fragments with the same fragment_id, each
+	// carried by its own relay message.
+	FragmentId    []byte `protobuf:"bytes,12,opt,name=fragment_id,json=fragmentId,proto3" json:"fragment_id,omitempty"`
+	FragmentIndex uint32 `protobuf:"varint,13,opt,name=fragment_index,json=fragmentIndex,proto3" json:"fragment_index,omitempty"`
+	NumFragments  uint32 `protobuf:"varint,14,opt,name=num_fragments,json=numFragments,proto3" json:"num_fragments,omitempty"`
+	// Each node on the path appends a hop if trace is true. Hops are not part
+	// of sigchain.
+	Trace     bool             `protobuf:"varint,15,opt,name=trace,proto3" json:"trace,omitempty"`
+	TraceHops []*RelayTraceHop `protobuf:"bytes,16,rep,name=trace_hops,json=traceHops" json:"trace_hops,omitempty"`
 }
 
 func (m *Relay) Reset()      { *m = Relay{} }
 func (*Relay) ProtoMessage() {}
 func (*Relay) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{14}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{14}
 }
 func (m *Relay) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1205,6 +1243,106 @@ func (m *Relay) GetSigChainLen() uint32 {
 	return 0
 }
 
+func (m *Relay) GetRequestAck() bool {
+	if m != nil {
+		return m.RequestAck
+	}
+	return false
+}
+
+func (m *Relay) GetMessageId() []byte {
+	if m != nil {
+		return m.MessageId
+	}
+	return nil
+}
+
+func (m *Relay) GetFragmentId() []byte {
+	if m != nil {
+		return m.FragmentId
+	}
+	return nil
+}
+
+func (m *Relay) GetFragmentIndex() uint32 {
+	if m != nil {
+		return m.FragmentIndex
+	}
+	return 0
+}
+
+func (m *Relay) GetNumFragments() uint32 {
+	if m != nil {
+		return m.NumFragments
+	}
+	return 0
+}
+
+func (m *Relay) GetTrace() bool {
+	if m != nil {
+		return m.Trace
+	}
+	return false
+}
+
+func (m *Relay) GetTraceHops() []*RelayTraceHop {
+	if m != nil {
+		return m.TraceHops
+	}
+	return nil
+}
+
+type RelayDeliveryAck struct {
+	SrcId []byte       `protobuf:"bytes,1,opt,name=src_id,json=srcId,proto3" json:"src_id,omitempty"`
+	Ack   *DeliveryAck `protobuf:"bytes,2,opt,name=ack" json:"ack,omitempty"`
+}
+
+func (m *RelayDeliveryAck) Reset()      { *m = RelayDeliveryAck{} }
+func (*RelayDeliveryAck) ProtoMessage() {}
+func (*RelayDeliveryAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{15}
+}
+func (m *RelayDeliveryAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayDeliveryAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayDeliveryAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RelayDeliveryAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayDeliveryAck.Merge(dst, src)
+}
+func (m *RelayDeliveryAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayDeliveryAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayDeliveryAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayDeliveryAck proto.InternalMessageInfo
+
+func (m *RelayDeliveryAck) GetSrcId() []byte {
+	if m != nil {
+		return m.SrcId
+	}
+	return nil
+}
+
+func (m *RelayDeliveryAck) GetAck() *DeliveryAck {
+	if m != nil {
+		return m.Ack
+	}
+	return nil
+}
+
 type Transactions struct {
 	Transactions []*Transaction `protobuf:"bytes,1,rep,name=transactions" json:"transactions,omitempty"`
 }
@@ -1212,7 +1350,7 @@ type Transactions struct {
 func (m *Transactions) Reset()      { *m = Transactions{} }
 func (*Transactions) ProtoMessage() {}
 func (*Transactions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{15}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{16}
 }
 func (m *Transactions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1256,7 +1394,7 @@ type BacktrackSignatureChain struct {
 func (m *BacktrackSignatureChain) Reset()      { *m = BacktrackSignatureChain{} }
 func (*BacktrackSignatureChain) ProtoMessage() {}
 func (*BacktrackSignatureChain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{16}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{17}
 }
 func (m *BacktrackSignatureChain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1307,7 +1445,7 @@ type IHaveSignatureChainTransaction struct {
 func (m *IHaveSignatureChainTransaction) Reset()      { *m = IHaveSignatureChainTransaction{} }
 func (*IHaveSignatureChainTransaction) ProtoMessage() {}
 func (*IHaveSignatureChainTransaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{17}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{18}
 }
 func (m *IHaveSignatureChainTransaction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1357,7 +1495,7 @@ type RequestSignatureChainTransaction struct {
 func (m *RequestSignatureChainTransaction) Reset()      { *m = RequestSignatureChainTransaction{} }
 func (*RequestSignatureChainTransaction) ProtoMessage() {}
 func (*RequestSignatureChainTransaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{18}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{19}
 }
 func (m *RequestSignatureChainTransaction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1400,7 +1538,7 @@ type RequestSignatureChainTransactionReply struct {
 func (m *RequestSignatureChainTransactionReply) Reset()      { *m = RequestSignatureChainTransactionReply{} }
 func (*RequestSignatureChainTransactionReply) ProtoMessage() {}
 func (*RequestSignatureChainTransactionReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_nodemessage_c02ceb21668725d0, []int{19}
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{20}
 }
 func (m *RequestSignatureChainTransactionReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1436,6 +1574,92 @@ func (m *RequestSignatureChainTransactionReply) GetTransaction() *Transaction {
 	return nil
 }
 
+type GetStateNodes struct {
+	Hashes [][]byte `protobuf:"bytes,1,rep,name=hashes" json:"hashes,omitempty"`
+}
+
+func (m *GetStateNodes) Reset()      { *m = GetStateNodes{} }
+func (*GetStateNodes) ProtoMessage() {}
+func (*GetStateNodes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{21}
+}
+func (m *GetStateNodes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetStateNodes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetStateNodes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetStateNodes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateNodes.Merge(dst, src)
+}
+func (m *GetStateNodes) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetStateNodes) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateNodes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateNodes proto.InternalMessageInfo
+
+func (m *GetStateNodes) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+type GetStateNodesReply struct {
+	Nodes [][]byte `protobuf:"bytes,1,rep,name=nodes" json:"nodes,omitempty"`
+}
+
+func (m *GetStateNodesReply) Reset()      { *m = GetStateNodesReply{} }
+func (*GetStateNodesReply) ProtoMessage() {}
+func (*GetStateNodesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_nodemessage_52d9bce566157537, []int{22}
+}
+func (m *GetStateNodesReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetStateNodesReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetStateNodesReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetStateNodesReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateNodesReply.Merge(dst, src)
+}
+func (m *GetStateNodesReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetStateNodesReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateNodesReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateNodesReply proto.InternalMessageInfo
+
+func (m *GetStateNodesReply) GetNodes() [][]byte {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*UnsignedMessage)(nil), "pb.UnsignedMessage")
 	proto.RegisterType((*SignedMessage)(nil), "pb.SignedMessage")
@@ -1452,11 +1676,14 @@ func init() {
 	proto.RegisterType((*GetBlocks)(nil), "pb.GetBlocks")
 	proto.RegisterType((*GetBlocksReply)(nil), "pb.GetBlocksReply")
 	proto.RegisterType((*Relay)(nil), "pb.Relay")
+	proto.RegisterType((*RelayDeliveryAck)(nil), "pb.RelayDeliveryAck")
 	proto.RegisterType((*Transactions)(nil), "pb.Transactions")
 	proto.RegisterType((*BacktrackSignatureChain)(nil), "pb.BacktrackSignatureChain")
 	proto.RegisterType((*IHaveSignatureChainTransaction)(nil), "pb.IHaveSignatureChainTransaction")
 	proto.RegisterType((*RequestSignatureChainTransaction)(nil), "pb.RequestSignatureChainTransaction")
 	proto.RegisterType((*RequestSignatureChainTransactionReply)(nil), "pb.RequestSignatureChainTransactionReply")
+	proto.RegisterType((*GetStateNodes)(nil), "pb.GetStateNodes")
+	proto.RegisterType((*GetStateNodesReply)(nil), "pb.GetStateNodesReply")
 	proto.RegisterEnum("pb.MessageType", MessageType_name, MessageType_value)
 	proto.RegisterEnum("pb.AllowedSignedMessageType", AllowedSignedMessageType_name, AllowedSignedMessageType_value)
 	proto.RegisterEnum("pb.AllowedUnsignedMessageType", AllowedUnsignedMessageType_name, AllowedUnsignedMessageType_value)
@@ -1985,6 +2212,59 @@ func (this *Relay) Equal(that interface{}) bool {
 	if this.SigChainLen != that1.SigChainLen {
 		return false
 	}
+	if this.RequestAck != that1.RequestAck {
+		return false
+	}
+	if !bytes.Equal(this.MessageId, that1.MessageId) {
+		return false
+	}
+	if !bytes.Equal(this.FragmentId, that1.FragmentId) {
+		return false
+	}
+	if this.FragmentIndex != that1.FragmentIndex {
+		return false
+	}
+	if this.NumFragments != that1.NumFragments {
+		return false
+	}
+	if this.Trace != that1.Trace {
+		return false
+	}
+	if len(this.TraceHops) != len(that1.TraceHops) {
+		return false
+	}
+	for i := range this.TraceHops {
+		if !this.TraceHops[i].Equal(that1.TraceHops[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RelayDeliveryAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RelayDeliveryAck)
+	if !ok {
+		that2, ok := that.(RelayDeliveryAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.SrcId, that1.SrcId) {
+		return false
+	}
+	if !this.Ack.Equal(that1.Ack) {
+		return false
+	}
 	return true
 }
 func (this *Transactions) Equal(that interface{}) bool {
@@ -2123,6 +2403,64 @@ func (this *RequestSignatureChainTransactionReply) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *GetStateNodes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetStateNodes)
+	if !ok {
+		that2, ok := that.(GetStateNodes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Hashes) != len(that1.Hashes) {
+		return false
+	}
+	for i := range this.Hashes {
+		if !bytes.Equal(this.Hashes[i], that1.Hashes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GetStateNodesReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetStateNodesReply)
+	if !ok {
+		that2, ok := that.(GetStateNodesReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Nodes) != len(that1.Nodes) {
+		return false
+	}
+	for i := range this.Nodes {
+		if !bytes.Equal(this.Nodes[i], that1.Nodes[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *UnsignedMessage) GoString() string {
 	if this == nil {
 		return "nil"
@@ -2292,7 +2630,7 @@ func (this *Relay) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 19)
 	s = append(s, "&pb.Relay{")
 	s = append(s, "SrcIdentifier: "+fmt.Sprintf("%#v", this.SrcIdentifier)+",\n")
 	s = append(s, "SrcPubkey: "+fmt.Sprintf("%#v", this.SrcPubkey)+",\n")
@@ -2302,6 +2640,28 @@ func (this *Relay) GoString() string {
 	s = append(s, "BlockHash: "+fmt.Sprintf("%#v", this.BlockHash)+",\n")
 	s = append(s, "LastSignature: "+fmt.Sprintf("%#v", this.LastSignature)+",\n")
 	s = append(s, "SigChainLen: "+fmt.Sprintf("%#v", this.SigChainLen)+",\n")
+	s = append(s, "RequestAck: "+fmt.Sprintf("%#v", this.RequestAck)+",\n")
+	s = append(s, "MessageId: "+fmt.Sprintf("%#v", this.MessageId)+",\n")
+	s = append(s, "FragmentId: "+fmt.Sprintf("%#v", this.FragmentId)+",\n")
+	s = append(s, "FragmentIndex: "+fmt.Sprintf("%#v", this.FragmentIndex)+",\n")
+	s = append(s, "NumFragments: "+fmt.Sprintf("%#v", this.NumFragments)+",\n")
+	s = append(s, "Trace: "+fmt.Sprintf("%#v", this.Trace)+",\n")
+	if this.TraceHops != nil {
+		s = append(s, "TraceHops: "+fmt.Sprintf("%#v", this.TraceHops)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RelayDeliveryAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.RelayDeliveryAck{")
+	s = append(s, "SrcId: "+fmt.Sprintf("%#v", this.SrcId)+",\n")
+	if this.Ack != nil {
+		s = append(s, "Ack: "+fmt.Sprintf("%#v", this.Ack)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2363,6 +2723,26 @@ func (this *RequestSignatureChainTransactionReply) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *GetStateNodes) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.GetStateNodes{")
+	s = append(s, "Hashes: "+fmt.Sprintf("%#v", this.Hashes)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetStateNodesReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.GetStateNodesReply{")
+	s = append(s, "Nodes: "+fmt.Sprintf("%#v", this.Nodes)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringNodemessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2881,10 +3261,66 @@ func (m *Relay) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintNodemessage(dAtA, i, uint64(m.SigChainLen))
 	}
+	if m.RequestAck {
+		dAtA[i] = 0x50
+		i++
+		if m.RequestAck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.MessageId) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintNodemessage(dAtA, i, uint64(len(m.MessageId)))
+		i += copy(dAtA[i:], m.MessageId)
+	}
+	if len(m.FragmentId) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintNodemessage(dAtA, i, uint64(len(m.FragmentId)))
+		i += copy(dAtA[i:], m.FragmentId)
+	}
+	if m.FragmentIndex != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintNodemessage(dAtA, i, uint64(m.FragmentIndex))
+	}
+	if m.NumFragments != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintNodemessage(dAtA, i, uint64(m.NumFragments))
+	}
+	if m.Trace {
+		dAtA[i] = 0x78
+		i++
+		if m.Trace {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.TraceHops) > 0 {
+		for _, msg := range m.TraceHops {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintNodemessage(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
-func (m *Transactions) Marshal() (dAtA []byte, err error) {
+func (m *RelayDeliveryAck) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2894,13 +3330,47 @@ func (m *Transactions) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Transactions) MarshalTo(dAtA []byte) (int, error) {
+func (m *RelayDeliveryAck) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Transactions) > 0 {
-		for _, msg := range m.Transactions {
+	if len(m.SrcId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNodemessage(dAtA, i, uint64(len(m.SrcId)))
+		i += copy(dAtA[i:], m.SrcId)
+	}
+	if m.Ack != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNodemessage(dAtA, i, uint64(m.Ack.Size()))
+		n2, err := m.Ack.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+
+func (m *Transactions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Transactions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for _, msg := range m.Transactions {
 			dAtA[i] = 0xa
 			i++
 			i = encodeVarintNodemessage(dAtA, i, uint64(msg.Size()))
@@ -3022,11 +3492,63 @@ func (m *RequestSignatureChainTransactionReply) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintNodemessage(dAtA, i, uint64(m.Transaction.Size()))
-		n2, err := m.Transaction.MarshalTo(dAtA[i:])
+		n3, err := m.Transaction.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n3
+	}
+	return i, nil
+}
+
+func (m *GetStateNodes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetStateNodes) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hashes) > 0 {
+		for _, b := range m.Hashes {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintNodemessage(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	return i, nil
+}
+
+func (m *GetStateNodesReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetStateNodesReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, b := range m.Nodes {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintNodemessage(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
 	}
 	return i, nil
 }
@@ -3042,7 +3564,7 @@ func encodeVarintNodemessage(dAtA []byte, offset int, v uint64) int {
 }
 func NewPopulatedUnsignedMessage(r randyNodemessage, easy bool) *UnsignedMessage {
 	this := &UnsignedMessage{}
-	this.MessageType = MessageType([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18}[r.Intn(19)])
+	this.MessageType = MessageType([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21}[r.Intn(22)])
 	v1 := r.Intn(100)
 	this.Message = make([]byte, v1)
 	for i := 0; i < v1; i++ {
@@ -3275,6 +3797,42 @@ func NewPopulatedRelay(r randyNodemessage, easy bool) *Relay {
 		this.LastSignature[i] = byte(r.Intn(256))
 	}
 	this.SigChainLen = uint32(r.Uint32())
+	this.RequestAck = bool(bool(r.Intn(2) == 0))
+	v23 := r.Intn(100)
+	this.MessageId = make([]byte, v23)
+	for i := 0; i < v23; i++ {
+		this.MessageId[i] = byte(r.Intn(256))
+	}
+	v24 := r.Intn(100)
+	this.FragmentId = make([]byte, v24)
+	for i := 0; i < v24; i++ {
+		this.FragmentId[i] = byte(r.Intn(256))
+	}
+	this.FragmentIndex = uint32(r.Uint32())
+	this.NumFragments = uint32(r.Uint32())
+	this.Trace = bool(bool(r.Intn(2) == 0))
+	if r.Intn(10) != 0 {
+		v25 := r.Intn(5)
+		this.TraceHops = make([]*RelayTraceHop, v25)
+		for i := 0; i < v25; i++ {
+			this.TraceHops[i] = NewPopulatedRelayTraceHop(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedRelayDeliveryAck(r randyNodemessage, easy bool) *RelayDeliveryAck {
+	this := &RelayDeliveryAck{}
+	v26 := r.Intn(100)
+	this.SrcId = make([]byte, v26)
+	for i := 0; i < v26; i++ {
+		this.SrcId[i] = byte(r.Intn(256))
+	}
+	if r.Intn(10) != 0 {
+		this.Ack = NewPopulatedDeliveryAck(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3283,9 +3841,9 @@ func NewPopulatedRelay(r randyNodemessage, easy bool) *Relay {
 func NewPopulatedTransactions(r randyNodemessage, easy bool) *Transactions {
 	this := &Transactions{}
 	if r.Intn(10) != 0 {
-		v23 := r.Intn(5)
-		this.Transactions = make([]*Transaction, v23)
-		for i := 0; i < v23; i++ {
+		v27 := r.Intn(5)
+		this.Transactions = make([]*Transaction, v27)
+		for i := 0; i < v27; i++ {
 			this.Transactions[i] = NewPopulatedTransaction(r, easy)
 		}
 	}
@@ -3297,15 +3855,15 @@ func NewPopulatedTransactions(r randyNodemessage, easy bool) *Transactions {
 func NewPopulatedBacktrackSignatureChain(r randyNodemessage, easy bool) *BacktrackSignatureChain {
 	this := &BacktrackSignatureChain{}
 	if r.Intn(10) != 0 {
-		v24 := r.Intn(5)
-		this.SigChainElems = make([]*SigChainElem, v24)
-		for i := 0; i < v24; i++ {
+		v28 := r.Intn(5)
+		this.SigChainElems = make([]*SigChainElem, v28)
+		for i := 0; i < v28; i++ {
 			this.SigChainElems[i] = NewPopulatedSigChainElem(r, easy)
 		}
 	}
-	v25 := r.Intn(100)
-	this.PrevSignature = make([]byte, v25)
-	for i := 0; i < v25; i++ {
+	v29 := r.Intn(100)
+	this.PrevSignature = make([]byte, v29)
+	for i := 0; i < v29; i++ {
 		this.PrevSignature[i] = byte(r.Intn(256))
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -3316,9 +3874,9 @@ func NewPopulatedBacktrackSignatureChain(r randyNodemessage, easy bool) *Backtra
 func NewPopulatedIHaveSignatureChainTransaction(r randyNodemessage, easy bool) *IHaveSignatureChainTransaction {
 	this := &IHaveSignatureChainTransaction{}
 	this.Height = uint32(r.Uint32())
-	v26 := r.Intn(100)
-	this.SignatureHash = make([]byte, v26)
-	for i := 0; i < v26; i++ {
+	v30 := r.Intn(100)
+	this.SignatureHash = make([]byte, v30)
+	for i := 0; i < v30; i++ {
 		this.SignatureHash[i] = byte(r.Intn(256))
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -3328,9 +3886,9 @@ func NewPopulatedIHaveSignatureChainTransaction(r randyNodemessage, easy bool) *
 
 func NewPopulatedRequestSignatureChainTransaction(r randyNodemessage, easy bool) *RequestSignatureChainTransaction {
 	this := &RequestSignatureChainTransaction{}
-	v27 := r.Intn(100)
-	this.SignatureHash = make([]byte, v27)
-	for i := 0; i < v27; i++ {
+	v31 := r.Intn(100)
+	this.SignatureHash = make([]byte, v31)
+	for i := 0; i < v31; i++ {
 		this.SignatureHash[i] = byte(r.Intn(256))
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -3348,6 +3906,38 @@ func NewPopulatedRequestSignatureChainTransactionReply(r randyNodemessage, easy
 	return this
 }
 
+func NewPopulatedGetStateNodes(r randyNodemessage, easy bool) *GetStateNodes {
+	this := &GetStateNodes{}
+	v32 := r.Intn(10)
+	this.Hashes = make([][]byte, v32)
+	for i := 0; i < v32; i++ {
+		v33 := r.Intn(100)
+		this.Hashes[i] = make([]byte, v33)
+		for j := 0; j < v33; j++ {
+			this.Hashes[i][j] = byte(r.Intn(256))
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetStateNodesReply(r randyNodemessage, easy bool) *GetStateNodesReply {
+	this := &GetStateNodesReply{}
+	v34 := r.Intn(10)
+	this.Nodes = make([][]byte, v34)
+	for i := 0; i < v34; i++ {
+		v35 := r.Intn(100)
+		this.Nodes[i] = make([]byte, v35)
+		for j := 0; j < v35; j++ {
+			this.Nodes[i][j] = byte(r.Intn(256))
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyNodemessage interface {
 	Float32() float32
 	Float64() float64
@@ -3367,9 +3957,9 @@ func randUTF8RuneNodemessage(r randyNodemessage) rune {
 	return rune(ru + 61)
 }
 func randStringNodemessage(r randyNodemessage) string {
-	v28 := r.Intn(100)
-	tmps := make([]rune, v28)
-	for i := 0; i < v28; i++ {
+	v36 := r.Intn(100)
+	tmps := make([]rune, v36)
+	for i := 0; i < v36; i++ {
 		tmps[i] = randUTF8RuneNodemessage(r)
 	}
 	return string(tmps)
@@ -3391,11 +3981,11 @@ func randFieldNodemessage(dAtA []byte, r randyNodemessage, fieldNumber int, wire
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateNodemessage(dAtA, uint64(key))
-		v29 := r.Int63()
+		v37 := r.Int63()
 		if r.Intn(2) == 0 {
-			v29 *= -1
+			v37 *= -1
 		}
-		dAtA = encodeVarintPopulateNodemessage(dAtA, uint64(v29))
+		dAtA = encodeVarintPopulateNodemessage(dAtA, uint64(v37))
 	case 1:
 		dAtA = encodeVarintPopulateNodemessage(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3701,6 +4291,49 @@ func (m *Relay) Size() (n int) {
 	if m.SigChainLen != 0 {
 		n += 1 + sovNodemessage(uint64(m.SigChainLen))
 	}
+	if m.RequestAck {
+		n += 2
+	}
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovNodemessage(uint64(l))
+	}
+	l = len(m.FragmentId)
+	if l > 0 {
+		n += 1 + l + sovNodemessage(uint64(l))
+	}
+	if m.FragmentIndex != 0 {
+		n += 1 + sovNodemessage(uint64(m.FragmentIndex))
+	}
+	if m.NumFragments != 0 {
+		n += 1 + sovNodemessage(uint64(m.NumFragments))
+	}
+	if m.Trace {
+		n += 2
+	}
+	if len(m.TraceHops) > 0 {
+		for _, e := range m.TraceHops {
+			l = e.Size()
+			n += 2 + l + sovNodemessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RelayDeliveryAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SrcId)
+	if l > 0 {
+		n += 1 + l + sovNodemessage(uint64(l))
+	}
+	if m.Ack != nil {
+		l = m.Ack.Size()
+		n += 1 + l + sovNodemessage(uint64(l))
+	}
 	return n
 }
 
@@ -3780,6 +4413,36 @@ func (m *RequestSignatureChainTransactionReply) Size() (n int) {
 	return n
 }
 
+func (m *GetStateNodes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hashes) > 0 {
+		for _, b := range m.Hashes {
+			l = len(b)
+			n += 1 + l + sovNodemessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetStateNodesReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, b := range m.Nodes {
+			l = len(b)
+			n += 1 + l + sovNodemessage(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovNodemessage(x uint64) (n int) {
 	for {
 		n++
@@ -3963,6 +4626,24 @@ func (this *Relay) String() string {
 		`BlockHash:` + fmt.Sprintf("%v", this.BlockHash) + `,`,
 		`LastSignature:` + fmt.Sprintf("%v", this.LastSignature) + `,`,
 		`SigChainLen:` + fmt.Sprintf("%v", this.SigChainLen) + `,`,
+		`RequestAck:` + fmt.Sprintf("%v", this.RequestAck) + `,`,
+		`MessageId:` + fmt.Sprintf("%v", this.MessageId) + `,`,
+		`FragmentId:` + fmt.Sprintf("%v", this.FragmentId) + `,`,
+		`FragmentIndex:` + fmt.Sprintf("%v", this.FragmentIndex) + `,`,
+		`NumFragments:` + fmt.Sprintf("%v", this.NumFragments) + `,`,
+		`Trace:` + fmt.Sprintf("%v", this.Trace) + `,`,
+		`TraceHops:` + strings.Replace(fmt.Sprintf("%v", this.TraceHops), "RelayTraceHop", "RelayTraceHop", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RelayDeliveryAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelayDeliveryAck{`,
+		`SrcId:` + fmt.Sprintf("%v", this.SrcId) + `,`,
+		`Ack:` + strings.Replace(fmt.Sprintf("%v", this.Ack), "DeliveryAck", "DeliveryAck", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4019,6 +4700,26 @@ func (this *RequestSignatureChainTransactionReply) String() string {
 	}, "")
 	return s
 }
+func (this *GetStateNodes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetStateNodes{`,
+		`Hashes:` + fmt.Sprintf("%v", this.Hashes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetStateNodesReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetStateNodesReply{`,
+		`Nodes:` + fmt.Sprintf("%v", this.Nodes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringNodemessage(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -5757,61 +6458,31 @@ func (m *Relay) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNodemessage(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthNodemessage
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Transactions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowNodemessage
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestAck", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodemessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Transactions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Transactions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.RequestAck = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNodemessage
@@ -5821,30 +6492,345 @@ func (m *Transactions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthNodemessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Transactions = append(m.Transactions, &Transaction{})
-			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MessageId = append(m.MessageId[:0], dAtA[iNdEx:postIndex]...)
+			if m.MessageId == nil {
+				m.MessageId = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipNodemessage(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FragmentId", wireType)
 			}
-			if skippy < 0 {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodemessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodemessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FragmentId = append(m.FragmentId[:0], dAtA[iNdEx:postIndex]...)
+			if m.FragmentId == nil {
+				m.FragmentId = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FragmentIndex", wireType)
+			}
+			m.FragmentIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodemessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FragmentIndex |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumFragments", wireType)
+			}
+			m.NumFragments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodemessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumFragments |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodemessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Trace = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceHops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodemessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodemessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceHops = append(m.TraceHops, &RelayTraceHop{})
+			if err := m.TraceHops[len(m.TraceHops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodemessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodemessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayDeliveryAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodemessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayDeliveryAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayDeliveryAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodemessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodemessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcId = append(m.SrcId[:0], dAtA[iNdEx:postIndex]...)
+			if m.SrcId == nil {
+				m.SrcId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodemessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodemessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ack == nil {
+				m.Ack = &DeliveryAck{}
+			}
+			if err := m.Ack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodemessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodemessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Transactions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodemessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Transactions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Transactions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodemessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNodemessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &Transaction{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodemessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthNodemessage
 			}
 			if (iNdEx + skippy) > l {
@@ -6235,6 +7221,164 @@ func (m *RequestSignatureChainTransactionReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetStateNodes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodemessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetStateNodes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetStateNodes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodemessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodemessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hashes = append(m.Hashes, make([]byte, postIndex-iNdEx))
+			copy(m.Hashes[len(m.Hashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodemessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodemessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetStateNodesReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNodemessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetStateNodesReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetStateNodesReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNodemessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNodemessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, make([]byte, postIndex-iNdEx))
+			copy(m.Nodes[len(m.Nodes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNodemessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNodemessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNodemessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6340,113 +7484,131 @@ var (
 	ErrIntOverflowNodemessage   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("pb/nodemessage.proto", fileDescriptor_nodemessage_c02ceb21668725d0) }
-
-var fileDescriptor_nodemessage_c02ceb21668725d0 = []byte{
-	// 1676 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x3d, 0x73, 0xdb, 0xc8,
-	0x19, 0x16, 0xf4, 0x65, 0xf3, 0x15, 0x29, 0x81, 0x6b, 0xd9, 0xa2, 0x65, 0x0b, 0x96, 0xe0, 0x93,
-	0x23, 0xf3, 0x7c, 0xe2, 0x9d, 0x9c, 0xdc, 0xdc, 0x64, 0x2e, 0x05, 0x48, 0x21, 0x22, 0xc7, 0x34,
-	0xc9, 0x00, 0x90, 0x2f, 0x4e, 0x83, 0x01, 0xc9, 0x35, 0x89, 0x31, 0x08, 0x30, 0x58, 0xc8, 0x31,
-	0x5d, 0xe5, 0x27, 0xa4, 0xce, 0x2f, 0x48, 0x9f, 0xc9, 0x4c, 0x7e, 0x42, 0xd2, 0xb9, 0xbc, 0x32,
-	0xa6, 0x9b, 0x24, 0xd5, 0x55, 0x99, 0x94, 0x19, 0x2c, 0x16, 0x10, 0x08, 0x02, 0x94, 0xed, 0x49,
-	0x71, 0x9d, 0xf6, 0x7d, 0x9f, 0xf7, 0x73, 0x9f, 0x67, 0xc1, 0x11, 0x6c, 0x8f, 0xbb, 0x15, 0xdb,
-	0xe9, 0xe3, 0x11, 0x26, 0xc4, 0x18, 0xe0, 0xe3, 0xb1, 0xeb, 0x78, 0x0e, 0x5a, 0x1e, 0x77, 0x77,
-	0xbf, 0x18, 0x98, 0xde, 0xf0, 0xa2, 0x7b, 0xdc, 0x73, 0x46, 0x95, 0x81, 0x33, 0x70, 0x2a, 0xd4,
-	0xd5, 0xbd, 0x78, 0x41, 0x4f, 0xf4, 0x40, 0xff, 0x0a, 0x42, 0x76, 0x0b, 0x2c, 0x11, 0x3b, 0x16,
-	0xc7, 0xdd, 0x0a, 0x31, 0x07, 0xbd, 0xa1, 0x61, 0xda, 0xcc, 0xb4, 0x39, 0xee, 0x56, 0xba, 0x96,
-	0xd3, 0x7b, 0xc9, 0xce, 0x7e, 0x69, 0xcf, 0x35, 0x6c, 0x62, 0xf4, 0x3c, 0xd3, 0x61, 0x28, 0x51,
-	0x87, 0xad, 0x73, 0x9b, 0x98, 0x03, 0x1b, 0xf7, 0x9f, 0x06, 0x3d, 0xa1, 0x13, 0xc8, 0xb3, 0xf6,
-	0x74, 0x6f, 0x32, 0xc6, 0x25, 0x6e, 0x9f, 0x3b, 0xda, 0x3c, 0xd9, 0x3a, 0x1e, 0x77, 0x8f, 0x19,
-	0x44, 0x9b, 0x8c, 0xb1, 0xb2, 0x31, 0xba, 0x3c, 0xa0, 0x12, 0x5c, 0x63, 0xc7, 0xd2, 0xf2, 0x3e,
-	0x77, 0x94, 0x57, 0xc2, 0xa3, 0x78, 0x06, 0x05, 0x75, 0x26, 0x7d, 0x0c, 0xca, 0xcd, 0x40, 0xd1,
-	0x5d, 0xc8, 0xf9, 0x9d, 0x18, 0xde, 0x85, 0x1b, 0xa6, 0xb9, 0x34, 0x88, 0xbf, 0x80, 0xd5, 0x67,
-	0x8e, 0x87, 0xd1, 0x2d, 0x58, 0x1f, 0x62, 0x73, 0x30, 0xf4, 0x68, 0x78, 0x41, 0x61, 0x27, 0xb4,
-	0x07, 0x40, 0xc7, 0xd5, 0x87, 0x06, 0x19, 0x86, 0xe1, 0xd4, 0x52, 0x37, 0xc8, 0x50, 0x7c, 0x02,
-	0xa8, 0x51, 0x37, 0x5e, 0xe1, 0xaa, 0x6f, 0xe9, 0xb8, 0xce, 0xd8, 0x21, 0x86, 0xf5, 0xa9, 0xc9,
-	0xfe, 0xc2, 0xc1, 0xb6, 0x82, 0x7f, 0x7b, 0x81, 0x89, 0x37, 0x9b, 0x6f, 0x36, 0x8e, 0x4b, 0xc4,
-	0xa1, 0x63, 0x58, 0xa5, 0x2b, 0x5d, 0xa6, 0x2b, 0xdd, 0xf5, 0x57, 0xca, 0xd2, 0x68, 0x97, 0x37,
-	0x43, 0xb7, 0x4b, 0x71, 0xe8, 0x01, 0x6c, 0x91, 0xa1, 0xe3, 0x7a, 0x34, 0x9d, 0x4e, 0x0c, 0xcb,
-	0x2b, 0xad, 0xd0, 0x9c, 0x05, 0x6a, 0xf6, 0x73, 0xaa, 0x86, 0xe5, 0x25, 0x71, 0xe6, 0x1b, 0x5c,
-	0x5a, 0xa5, 0xf3, 0xc4, 0x70, 0xe6, 0x1b, 0x2c, 0x9a, 0x70, 0x3b, 0xad, 0x6d, 0x05, 0x8f, 0xad,
-	0x09, 0xba, 0x07, 0x6b, 0xb4, 0x53, 0xda, 0xf6, 0xc6, 0x49, 0xce, 0xef, 0x8e, 0xc2, 0x94, 0xc0,
-	0x8e, 0x3e, 0x87, 0x62, 0x8c, 0x40, 0x24, 0xdc, 0xcd, 0xca, 0x51, 0x5e, 0xe1, 0xe3, 0x0e, 0xba,
-	0xa2, 0x7f, 0x71, 0x70, 0x87, 0xd5, 0x0a, 0xcb, 0xc4, 0x66, 0x24, 0x3f, 0xf2, 0x4d, 0xa5, 0xcf,
-	0xba, 0x96, 0x31, 0xeb, 0x77, 0xb0, 0xbf, 0x60, 0xd4, 0x60, 0xbb, 0x8f, 0x21, 0x1f, 0x8f, 0x2b,
-	0x71, 0xfb, 0x2b, 0x47, 0x1b, 0x81, 0xaa, 0x62, 0x60, 0x65, 0x06, 0x24, 0xde, 0x80, 0xe2, 0x19,
-	0xf6, 0x6a, 0x8e, 0x4d, 0xb0, 0x4d, 0x2e, 0x88, 0xea, 0x19, 0x1e, 0x16, 0xff, 0xc3, 0xc1, 0xad,
-	0x39, 0x6b, 0x50, 0xe4, 0x3e, 0x14, 0x2c, 0xdc, 0x1f, 0x60, 0x57, 0x9f, 0x61, 0x75, 0x3e, 0x30,
-	0xd6, 0x03, 0x6e, 0x97, 0xa1, 0xc8, 0x40, 0x73, 0x14, 0xdf, 0x0a, 0x1c, 0xd5, 0xe8, 0x1a, 0x1e,
-	0x02, 0xdf, 0x0b, 0xeb, 0x84, 0x39, 0x57, 0x68, 0xce, 0xad, 0xc8, 0xce, 0xd2, 0x3e, 0x02, 0x20,
-	0x13, 0xbb, 0xa7, 0x13, 0xbf, 0x1d, 0xba, 0xd4, 0xcd, 0x93, 0x82, 0x3f, 0x9e, 0x3a, 0xb1, 0x7b,
-	0x41, 0x8f, 0x39, 0x12, 0xfe, 0x89, 0x4e, 0xe0, 0xe6, 0xc8, 0xb4, 0xf5, 0x57, 0xd8, 0x35, 0x5f,
-	0x98, 0x46, 0xd7, 0xc2, 0x61, 0xf6, 0x35, 0x9a, 0xfd, 0xc6, 0xc8, 0xb4, 0x9f, 0x45, 0xbe, 0xa0,
-	0x82, 0xa8, 0xc2, 0xd6, 0x19, 0x0e, 0x98, 0x5b, 0xc7, 0x46, 0x1f, 0xbb, 0x04, 0x1d, 0x40, 0x9e,
-	0x78, 0x86, 0x7f, 0x9d, 0xf1, 0x79, 0x37, 0xa8, 0xad, 0x1e, 0x49, 0x19, 0xdb, 0xfd, 0x10, 0xb0,
-	0x4c, 0x01, 0x39, 0x6c, 0xf7, 0x59, 0xd2, 0x33, 0xd8, 0x4e, 0x24, 0x0d, 0x56, 0x59, 0x81, 0x02,
-	0x5b, 0x4f, 0x60, 0x65, 0x17, 0x06, 0xfe, 0x44, 0x01, 0x50, 0xc9, 0x77, 0x63, 0x51, 0xe2, 0x53,
-	0xc8, 0x85, 0x89, 0xfe, 0x1f, 0x7d, 0x3d, 0x86, 0xcd, 0x28, 0x5d, 0xd0, 0xd1, 0x01, 0xac, 0xd3,
-	0x82, 0x61, 0x2b, 0x31, 0x81, 0x32, 0x87, 0xf8, 0xc7, 0x65, 0x58, 0x53, 0xb0, 0x65, 0x4c, 0xd0,
-	0x21, 0x6c, 0x12, 0xb7, 0xa7, 0x9b, 0x7d, 0x6c, 0x7b, 0xe6, 0x0b, 0x13, 0xbb, 0xb4, 0x85, 0x9c,
-	0x52, 0x20, 0x6e, 0xaf, 0x11, 0x19, 0xd1, 0x0e, 0x5c, 0xeb, 0x63, 0xe2, 0xe9, 0x66, 0x9f, 0x31,
-	0x60, 0xdd, 0x3f, 0x36, 0xfa, 0xfe, 0x2b, 0x3d, 0x36, 0x26, 0x96, 0x63, 0xf4, 0x99, 0x8e, 0xc2,
-	0x23, 0x3a, 0x86, 0x1b, 0x23, 0xe3, 0xb5, 0x3e, 0x74, 0xac, 0xbe, 0x69, 0x0f, 0x74, 0x82, 0x7b,
-	0x8e, 0xdd, 0x27, 0xec, 0xde, 0x8a, 0x23, 0xe3, 0x75, 0x3d, 0xf0, 0xa8, 0x81, 0xc3, 0x9f, 0xd3,
-	0xef, 0x64, 0x7c, 0xd1, 0x7d, 0x89, 0x27, 0xa5, 0x75, 0xf6, 0xac, 0xbb, 0xbd, 0x0e, 0x35, 0x24,
-	0xde, 0x81, 0x6b, 0xc9, 0x77, 0xe0, 0x10, 0x36, 0x2d, 0x83, 0x78, 0xfa, 0xe5, 0x87, 0xe1, 0x7a,
-	0x20, 0x6b, 0xdf, 0xaa, 0x86, 0x46, 0x24, 0x42, 0x81, 0x98, 0x03, 0x9d, 0x7e, 0xff, 0x74, 0x0b,
-	0xdb, 0xa5, 0x1c, 0x5b, 0xb8, 0x39, 0xa8, 0xf9, 0xb6, 0x26, 0xb6, 0xc5, 0x1a, 0xe4, 0x67, 0x5e,
-	0xa0, 0x4f, 0x52, 0xe4, 0x1b, 0xd8, 0xa9, 0x1a, 0xbd, 0x97, 0x9e, 0x6b, 0xf4, 0x5e, 0x46, 0xe5,
-	0x69, 0x09, 0xf4, 0x0d, 0x6c, 0x5d, 0xf6, 0x80, 0x2d, 0x3c, 0x0a, 0x53, 0xf2, 0x54, 0x05, 0xac,
-	0x13, 0xd9, 0xc2, 0x23, 0xa5, 0x40, 0x62, 0x27, 0xe2, 0x0f, 0x39, 0x76, 0xf1, 0x2b, 0x3d, 0xf9,
-	0xf5, 0x2b, 0xf8, 0xd6, 0xa8, 0x8a, 0xa8, 0x83, 0x40, 0x3f, 0x61, 0xb3, 0x75, 0x63, 0xbd, 0x66,
-	0x7e, 0xce, 0x7c, 0x36, 0x84, 0x41, 0x71, 0xbd, 0x17, 0x22, 0x2b, 0x7d, 0xc7, 0x1a, 0xd1, 0x3b,
-	0x96, 0x5d, 0x62, 0x3e, 0x15, 0x97, 0x96, 0xea, 0x37, 0x70, 0x78, 0x55, 0xaa, 0x80, 0xd5, 0x5f,
-	0xc1, 0x46, 0x6c, 0xc1, 0xec, 0xdb, 0x33, 0x77, 0x09, 0x71, 0x4c, 0xf9, 0xcf, 0xab, 0xb0, 0x11,
-	0xfb, 0x25, 0x82, 0x7e, 0x02, 0xf7, 0x9f, 0xca, 0xaa, 0x2a, 0x9d, 0xc9, 0xba, 0xf6, 0xbc, 0x23,
-	0xeb, 0x9d, 0xa6, 0x54, 0x93, 0xeb, 0xed, 0xe6, 0xa9, 0xac, 0xe8, 0xa7, 0x6d, 0xbd, 0xd5, 0xd6,
-	0xf4, 0x73, 0x55, 0xe6, 0x97, 0xd0, 0x75, 0x58, 0x7d, 0xd6, 0xd6, 0x64, 0x9e, 0x43, 0xb7, 0xe1,
-	0x66, 0x43, 0xaf, 0x4b, 0xcf, 0x64, 0xbd, 0xda, 0x6c, 0xd7, 0x9e, 0xe8, 0x1d, 0xa5, 0xdd, 0x69,
-	0xab, 0x52, 0x93, 0x5f, 0x46, 0xbb, 0x70, 0x4b, 0x91, 0x7f, 0x75, 0x2e, 0xab, 0x5a, 0xd2, 0xb7,
-	0x82, 0xf6, 0xe1, 0x6e, 0xba, 0x4f, 0x57, 0xe4, 0x4e, 0xf3, 0x39, 0xbf, 0x8a, 0x76, 0xe0, 0xc6,
-	0x99, 0xac, 0xe9, 0xb5, 0x76, 0x4b, 0x95, 0x5b, 0xea, 0xb9, 0xaa, 0xab, 0x9a, 0xa4, 0xc9, 0xfc,
-	0x1a, 0xda, 0x83, 0xdb, 0x29, 0x0e, 0x16, 0xb7, 0x8e, 0x6e, 0x42, 0xd1, 0x77, 0x07, 0x59, 0xeb,
-	0xb2, 0x74, 0x2a, 0x2b, 0x2a, 0x7f, 0x0d, 0xdd, 0x81, 0x9d, 0x39, 0x33, 0x8b, 0xb9, 0x8e, 0x36,
-	0x01, 0x22, 0xa7, 0xca, 0xe7, 0xd0, 0x36, 0xf0, 0x97, 0x67, 0x86, 0x02, 0x94, 0x83, 0x35, 0x45,
-	0x6e, 0x4a, 0xcf, 0xf9, 0x0d, 0xc4, 0x43, 0x5e, 0x53, 0xa4, 0x96, 0x2a, 0xd5, 0xb4, 0x46, 0xbb,
-	0xa5, 0xf2, 0x79, 0xbf, 0xab, 0xaa, 0x54, 0x7b, 0xa2, 0x29, 0x52, 0xed, 0x89, 0xae, 0x36, 0xce,
-	0x5a, 0x92, 0x76, 0xae, 0xc8, 0x7a, 0xad, 0x2e, 0x35, 0x5a, 0x7c, 0x01, 0x1d, 0xc0, 0x5e, 0x38,
-	0x6f, 0x34, 0xe9, 0x4c, 0x86, 0x4d, 0x7f, 0xf9, 0x0b, 0x21, 0xac, 0x8f, 0x2d, 0xf4, 0x00, 0x44,
-	0xb6, 0xf2, 0x44, 0x9d, 0x38, 0x9c, 0xe7, 0xe3, 0x09, 0x17, 0x01, 0x8b, 0xe8, 0x0b, 0x78, 0xf8,
-	0x01, 0x40, 0x56, 0x1f, 0x95, 0x6b, 0x50, 0x92, 0x2c, 0xcb, 0xf9, 0x1d, 0xee, 0xcf, 0xfc, 0x1e,
-	0x0d, 0x19, 0x24, 0x35, 0x9b, 0xed, 0xef, 0x68, 0x22, 0xf9, 0x34, 0x93, 0x41, 0xe5, 0xbf, 0xaf,
-	0xc3, 0x2e, 0xcb, 0x92, 0xf8, 0xd9, 0x4c, 0xf3, 0x3c, 0x84, 0xc3, 0x20, 0xcf, 0x79, 0xeb, 0x8a,
-	0x4c, 0x3e, 0x51, 0x12, 0x50, 0x46, 0xcd, 0x23, 0xf8, 0x2c, 0xe1, 0xc8, 0x62, 0xea, 0x7c, 0xb5,
-	0x4c, 0xe2, 0x3e, 0x00, 0x71, 0x21, 0x34, 0xa4, 0xef, 0x3c, 0x2e, 0x9d, 0xcd, 0x8f, 0xe0, 0xe8,
-	0x6a, 0x5c, 0x44, 0xee, 0xcf, 0x60, 0x3f, 0x05, 0x9d, 0xe4, 0x7a, 0x19, 0x1e, 0x5c, 0x85, 0x8a,
-	0xa8, 0xbf, 0x07, 0xb7, 0xb3, 0xb0, 0xbe, 0x12, 0xee, 0xc3, 0xbd, 0x4c, 0x77, 0x24, 0x8c, 0x12,
-	0x6c, 0xcf, 0xed, 0x24, 0xd0, 0xc9, 0x3d, 0xb8, 0x93, 0xf0, 0x24, 0x64, 0x33, 0x3f, 0xfe, 0x22,
-	0x15, 0x7d, 0x09, 0x8f, 0x32, 0x96, 0x9f, 0x25, 0xaa, 0xaf, 0xe1, 0xe4, 0x63, 0x22, 0x22, 0x8d,
-	0xfd, 0x0c, 0xbe, 0x4a, 0xe7, 0xce, 0x62, 0xc9, 0x65, 0x97, 0x5b, 0xac, 0xc0, 0x6f, 0xe1, 0x9b,
-	0x8f, 0x8f, 0x8b, 0x04, 0xf9, 0xef, 0xb5, 0x48, 0x91, 0xa7, 0xa6, 0x8b, 0x7b, 0x5e, 0xaa, 0x22,
-	0x4f, 0x1b, 0x8a, 0x5c, 0xd3, 0xb2, 0x75, 0x74, 0x13, 0x8a, 0x33, 0x40, 0xa6, 0xa2, 0x88, 0xc8,
-	0xcc, 0x9c, 0xa5, 0xa1, 0x64, 0x9d, 0x4c, 0x05, 0x45, 0x1c, 0x4e, 0x05, 0x86, 0xfa, 0x49, 0xa2,
-	0xd2, 0xd5, 0x13, 0x31, 0x3d, 0x1b, 0x15, 0x69, 0x47, 0x04, 0x61, 0x0e, 0x9b, 0x54, 0x4e, 0xf4,
-	0x64, 0x64, 0x61, 0x22, 0xdd, 0xdc, 0x81, 0x9d, 0x74, 0xa4, 0xaf, 0x9a, 0x03, 0xd8, 0xcb, 0x70,
-	0x46, 0x9a, 0x49, 0x76, 0xbe, 0x88, 0xf6, 0xc7, 0x50, 0x4e, 0xdd, 0x58, 0x16, 0xe9, 0x7f, 0x0a,
-	0x5f, 0x7e, 0x38, 0x3e, 0xa2, 0xfc, 0x63, 0xa8, 0xa4, 0x5d, 0xf4, 0x62, 0xc2, 0x67, 0x95, 0x5a,
-	0x4c, 0xf7, 0x9f, 0xc3, 0xd7, 0x1f, 0x1b, 0x15, 0x91, 0xfd, 0xd7, 0xb0, 0xc3, 0xb8, 0x4e, 0x7f,
-	0x9f, 0xc7, 0xa9, 0x1e, 0x51, 0x95, 0x3e, 0x3f, 0x1f, 0xc0, 0xf4, 0x00, 0xc7, 0x1e, 0xab, 0xf2,
-	0x04, 0xee, 0xb1, 0xcc, 0x55, 0xd7, 0x31, 0xfa, 0x3d, 0x83, 0x78, 0x9d, 0x0b, 0x32, 0x8c, 0x57,
-	0xa8, 0xc0, 0xe7, 0x41, 0x64, 0x55, 0x69, 0x4b, 0xa7, 0x35, 0xc9, 0x5f, 0xea, 0xb9, 0x5a, 0xcf,
-	0x2e, 0x75, 0x08, 0x07, 0xa9, 0x01, 0xb3, 0xcf, 0x60, 0x59, 0x49, 0x2b, 0x6d, 0x59, 0x57, 0x96,
-	0x6e, 0x36, 0xb3, 0xbf, 0xb0, 0x29, 0xe3, 0x68, 0x2e, 0xc6, 0x57, 0xe4, 0xd4, 0x14, 0x59, 0xfe,
-	0xa8, 0x71, 0x68, 0x40, 0x62, 0x9c, 0xd7, 0x70, 0x2b, 0xfd, 0x7f, 0x0c, 0xe8, 0x2e, 0x94, 0xc2,
-	0xcb, 0xfe, 0xa5, 0xdf, 0x7d, 0x9c, 0x17, 0x4b, 0x71, 0x6f, 0xfc, 0xea, 0xeb, 0x92, 0x5a, 0xe7,
-	0x39, 0x5f, 0xc0, 0x69, 0x5e, 0xb5, 0xde, 0x56, 0xb4, 0x00, 0xb3, 0x5c, 0xfd, 0xf6, 0xed, 0x3b,
-	0x61, 0xe9, 0xfb, 0x77, 0xc2, 0xd2, 0x0f, 0xef, 0x04, 0xee, 0xbf, 0xef, 0x04, 0xee, 0xf7, 0x53,
-	0x81, 0xfb, 0xd3, 0x54, 0xe0, 0xfe, 0x3a, 0x15, 0xb8, 0xbf, 0x4d, 0x05, 0xee, 0xed, 0x54, 0xe0,
-	0xfe, 0x31, 0x15, 0xb8, 0x7f, 0x4e, 0x85, 0xa5, 0x1f, 0xa6, 0x02, 0xf7, 0x87, 0xf7, 0xc2, 0xd2,
-	0xdb, 0xf7, 0xc2, 0xd2, 0xf7, 0xef, 0x85, 0xa5, 0xee, 0x3a, 0xfd, 0x57, 0xde, 0xe3, 0xff, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x7e, 0x2d, 0xb6, 0xc0, 0x5d, 0x14, 0x00, 0x00,
+func init() { proto.RegisterFile("pb/nodemessage.proto", fileDescriptor_nodemessage_52d9bce566157537) }
+
+var fileDescriptor_nodemessage_52d9bce566157537 = []byte{
+	// 1954 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x73, 0xdb, 0xc6,
+	0x15, 0x17, 0xf4, 0xd7, 0x7c, 0x22, 0x45, 0x68, 0xf5, 0x8f, 0x96, 0x2d, 0x4a, 0xa2, 0x23, 0x47,
+	0x56, 0x1c, 0xc9, 0x91, 0xdb, 0x4c, 0xa6, 0x93, 0x1e, 0x20, 0x12, 0x11, 0x39, 0xa2, 0x49, 0x15,
+	0xa0, 0x94, 0x71, 0x2f, 0x18, 0x90, 0x58, 0x93, 0x18, 0x81, 0x00, 0x8b, 0x85, 0x5c, 0xd3, 0xd3,
+	0x43, 0xbf, 0x41, 0xfb, 0x31, 0xfa, 0x01, 0xda, 0x99, 0x9e, 0x7a, 0xee, 0xd1, 0xc7, 0x1c, 0x6b,
+	0xf9, 0xd2, 0xde, 0x72, 0xea, 0x74, 0xa6, 0x97, 0xce, 0x2e, 0x16, 0x10, 0x08, 0x02, 0x54, 0x9c,
+	0xe9, 0x21, 0x37, 0xec, 0x7b, 0xbf, 0x7d, 0xff, 0x7f, 0x0f, 0x20, 0x61, 0x75, 0xd0, 0x3e, 0xb2,
+	0x1d, 0x03, 0xf7, 0x31, 0x21, 0x7a, 0x17, 0x1f, 0x0e, 0x5c, 0xc7, 0x73, 0xd0, 0xf4, 0xa0, 0xbd,
+	0xf9, 0x79, 0xd7, 0xf4, 0x7a, 0xd7, 0xed, 0xc3, 0x8e, 0xd3, 0x3f, 0xea, 0x3a, 0x5d, 0xe7, 0x88,
+	0xa9, 0xda, 0xd7, 0xaf, 0xd8, 0x89, 0x1d, 0xd8, 0x93, 0x7f, 0x65, 0x33, 0xc7, 0x0d, 0xf1, 0xe3,
+	0xf2, 0xa0, 0x7d, 0x44, 0xcc, 0x6e, 0xa7, 0xa7, 0x9b, 0x36, 0x17, 0x2d, 0x0d, 0xda, 0x47, 0x6d,
+	0xcb, 0xe9, 0x5c, 0xf1, 0x33, 0x75, 0xed, 0xb9, 0xba, 0x4d, 0xf4, 0x8e, 0x67, 0x3a, 0x01, 0x6a,
+	0x7d, 0xd0, 0x3e, 0xea, 0x58, 0x26, 0xb6, 0xbd, 0x91, 0x90, 0x4a, 0x1a, 0xe4, 0x2f, 0x6c, 0x62,
+	0x76, 0x6d, 0x6c, 0xbc, 0xf0, 0x15, 0xe8, 0x18, 0xb2, 0x1c, 0xa3, 0x79, 0xc3, 0x01, 0x2e, 0x08,
+	0x3b, 0xc2, 0xfe, 0xd2, 0x71, 0xfe, 0x70, 0xd0, 0x3e, 0xe4, 0x90, 0xd6, 0x70, 0x80, 0x95, 0xc5,
+	0xfe, 0xed, 0x01, 0x15, 0x60, 0x81, 0x1f, 0x0b, 0xd3, 0x3b, 0xc2, 0x7e, 0x56, 0x09, 0x8e, 0xa5,
+	0x53, 0xc8, 0xa9, 0x23, 0xe6, 0x23, 0x50, 0x61, 0x04, 0x8a, 0x1e, 0x42, 0x86, 0x46, 0xa2, 0x7b,
+	0xd7, 0x6e, 0x60, 0xe6, 0x56, 0x50, 0xfa, 0x25, 0xcc, 0x5e, 0x3a, 0x1e, 0x46, 0xeb, 0x30, 0xdf,
+	0xc3, 0x66, 0xb7, 0xe7, 0xb1, 0xeb, 0x39, 0x85, 0x9f, 0xd0, 0x16, 0x00, 0x2b, 0x83, 0xd6, 0xd3,
+	0x49, 0x2f, 0xb8, 0xce, 0x24, 0x55, 0x9d, 0xf4, 0x4a, 0x67, 0x80, 0x6a, 0x55, 0xfd, 0x35, 0x3e,
+	0xa1, 0x92, 0x73, 0xd7, 0x19, 0x38, 0x44, 0xb7, 0x7e, 0xac, 0xb1, 0xbf, 0x08, 0xb0, 0xaa, 0xe0,
+	0xdf, 0x5c, 0x63, 0xe2, 0x8d, 0xda, 0x1b, 0xbd, 0x27, 0xc4, 0xee, 0xa1, 0x43, 0x98, 0x65, 0x25,
+	0x9d, 0x66, 0x25, 0xdd, 0xa4, 0x25, 0xe5, 0x66, 0x5a, 0xb7, 0x1d, 0x63, 0xd5, 0x65, 0x38, 0xf4,
+	0x18, 0xf2, 0xa4, 0xe7, 0xb8, 0x1e, 0x33, 0xa7, 0x11, 0xdd, 0xf2, 0x0a, 0x33, 0xcc, 0x66, 0x8e,
+	0x89, 0xa9, 0x4d, 0x55, 0xb7, 0xbc, 0x38, 0xce, 0x7c, 0x8b, 0x0b, 0xb3, 0x2c, 0x9f, 0x08, 0xce,
+	0x7c, 0x8b, 0x4b, 0x26, 0xdc, 0x4f, 0x0a, 0x5b, 0xc1, 0x03, 0x6b, 0x88, 0xb6, 0x61, 0x8e, 0x45,
+	0xca, 0xc2, 0x5e, 0x3c, 0xce, 0xd0, 0xe8, 0x18, 0x4c, 0xf1, 0xe5, 0xe8, 0x33, 0x58, 0x8e, 0x0c,
+	0x16, 0x09, 0x6a, 0x33, 0xb3, 0x9f, 0x55, 0xc4, 0xa8, 0x82, 0x95, 0xe8, 0x5f, 0x02, 0x3c, 0xe0,
+	0xbe, 0x02, 0x37, 0x91, 0x1c, 0xc9, 0x4f, 0xbc, 0x52, 0xc9, 0xb9, 0xce, 0xa5, 0xe4, 0xfa, 0x2d,
+	0xec, 0x4c, 0x48, 0xd5, 0xaf, 0xee, 0x73, 0xc8, 0x46, 0xef, 0x15, 0x84, 0x9d, 0x99, 0xfd, 0x45,
+	0x9f, 0x55, 0x11, 0xb0, 0x32, 0x02, 0x2a, 0xad, 0xc0, 0xf2, 0x29, 0xf6, 0xca, 0x8e, 0x4d, 0xb0,
+	0x4d, 0xae, 0x89, 0xea, 0xe9, 0x1e, 0x2e, 0xfd, 0x5b, 0x80, 0xf5, 0x31, 0xa9, 0xef, 0xe4, 0x11,
+	0xe4, 0x2c, 0x6c, 0x74, 0xb1, 0xab, 0x8d, 0x4c, 0x75, 0xd6, 0x17, 0x56, 0x99, 0x0c, 0x1d, 0xc0,
+	0x32, 0x07, 0x8d, 0x8d, 0x78, 0xde, 0x57, 0x9c, 0x84, 0x6d, 0x78, 0x02, 0x62, 0x27, 0xf0, 0x13,
+	0xd8, 0x9c, 0x61, 0x36, 0xf3, 0xa1, 0x9c, 0x9b, 0x7d, 0x0a, 0x40, 0x86, 0x76, 0x47, 0x23, 0x34,
+	0x1c, 0x56, 0xd4, 0xa5, 0xe3, 0x1c, 0x4d, 0x4f, 0x1d, 0xda, 0x1d, 0x3f, 0xc6, 0x0c, 0x09, 0x1e,
+	0xd1, 0x31, 0xac, 0xf5, 0x4d, 0x5b, 0x7b, 0x8d, 0x5d, 0xf3, 0x95, 0xa9, 0xb7, 0x2d, 0x1c, 0x58,
+	0x9f, 0x63, 0xd6, 0x57, 0xfa, 0xa6, 0x7d, 0x19, 0xea, 0x7c, 0x0f, 0x25, 0x15, 0xf2, 0xa7, 0xd8,
+	0x9f, 0xdc, 0x2a, 0xd6, 0x0d, 0xec, 0x12, 0xb4, 0x0b, 0x59, 0xe2, 0xe9, 0xb4, 0x9d, 0xd1, 0x7c,
+	0x17, 0x99, 0xac, 0x1a, 0x52, 0x19, 0xdb, 0x46, 0x00, 0x98, 0x66, 0x80, 0x0c, 0xb6, 0x0d, 0x6e,
+	0xf4, 0x14, 0x56, 0x63, 0x46, 0xfd, 0x52, 0x1e, 0x41, 0x8e, 0x97, 0xc7, 0x97, 0xf2, 0x86, 0x01,
+	0xcd, 0xc8, 0x07, 0x2a, 0xd9, 0x76, 0xe4, 0x56, 0xe9, 0x05, 0x64, 0x02, 0x43, 0xff, 0x8f, 0xb8,
+	0x9e, 0xc3, 0x52, 0x68, 0xce, 0x8f, 0x68, 0x17, 0xe6, 0x99, 0xc3, 0x20, 0x94, 0x08, 0x41, 0xb9,
+	0xa2, 0xf4, 0x87, 0x59, 0x98, 0x53, 0xb0, 0xa5, 0x0f, 0xd1, 0x1e, 0x2c, 0x11, 0xb7, 0xa3, 0x99,
+	0x06, 0xb6, 0x3d, 0xf3, 0x95, 0x89, 0x5d, 0x16, 0x42, 0x46, 0xc9, 0x11, 0xb7, 0x53, 0x0b, 0x85,
+	0x68, 0x03, 0x16, 0x0c, 0x4c, 0x3c, 0xcd, 0x34, 0xf8, 0x04, 0xcc, 0xd3, 0x63, 0xcd, 0xa0, 0x5b,
+	0x7a, 0xa0, 0x0f, 0x2d, 0x47, 0x37, 0x38, 0x8f, 0x82, 0x23, 0x3a, 0x84, 0x95, 0xbe, 0xfe, 0x46,
+	0xeb, 0x39, 0x96, 0x61, 0xda, 0x5d, 0x8d, 0xe0, 0x8e, 0x63, 0x1b, 0x84, 0xf7, 0x6d, 0xb9, 0xaf,
+	0xbf, 0xa9, 0xfa, 0x1a, 0xd5, 0x57, 0xd0, 0x3c, 0x69, 0x24, 0x83, 0xeb, 0xf6, 0x15, 0x1e, 0x16,
+	0xe6, 0xf9, 0x5a, 0x77, 0x3b, 0xe7, 0x4c, 0x10, 0xdb, 0x03, 0x0b, 0xf1, 0x3d, 0xb0, 0x07, 0x4b,
+	0x96, 0x4e, 0x3c, 0xed, 0xf6, 0xc5, 0x70, 0xcf, 0xa7, 0x35, 0x95, 0xaa, 0x81, 0x10, 0x95, 0x20,
+	0x47, 0xcc, 0xae, 0xc6, 0xde, 0x8b, 0x9a, 0x85, 0xed, 0x42, 0x86, 0x17, 0xdc, 0xec, 0x96, 0xa9,
+	0xac, 0x8e, 0x6d, 0xb4, 0x0d, 0x8b, 0xae, 0xcf, 0x52, 0x4d, 0xef, 0x5c, 0x15, 0x60, 0x47, 0xd8,
+	0xbf, 0xa7, 0x00, 0x17, 0x49, 0x9d, 0x2b, 0x1a, 0x4a, 0xf0, 0xe2, 0x33, 0x8d, 0xc2, 0xa2, 0x1f,
+	0x0a, 0x97, 0xd4, 0x0c, 0x7a, 0xff, 0x95, 0xab, 0x77, 0xfb, 0xd8, 0x66, 0xf5, 0xca, 0x32, 0x3d,
+	0x04, 0xa2, 0x9a, 0x41, 0x63, 0xbd, 0x05, 0xd8, 0x06, 0x7e, 0x53, 0xc8, 0xf9, 0xab, 0x25, 0xc4,
+	0x50, 0x21, 0x25, 0xa9, 0x7d, 0xdd, 0xd7, 0x02, 0x21, 0x29, 0x2c, 0xf9, 0x24, 0xb5, 0xaf, 0xfb,
+	0xdf, 0x04, 0x32, 0xb4, 0x0a, 0x73, 0x9e, 0xab, 0x77, 0x70, 0x21, 0xcf, 0xc2, 0xf4, 0x0f, 0xe8,
+	0x19, 0x00, 0x7b, 0xd0, 0x7a, 0xce, 0x80, 0x14, 0x44, 0x36, 0x06, 0xcb, 0xfe, 0x6e, 0xb4, 0xf4,
+	0x61, 0x8b, 0xaa, 0xaa, 0xce, 0x40, 0xc9, 0x78, 0xfc, 0x89, 0x94, 0xea, 0x20, 0x32, 0x5d, 0x05,
+	0x5b, 0xe6, 0x6b, 0xec, 0x0e, 0x69, 0x9e, 0x6b, 0x30, 0xef, 0xcf, 0x06, 0x5f, 0xbb, 0x73, 0x6c,
+	0x26, 0xd0, 0x2e, 0xcc, 0xd0, 0xba, 0x4c, 0xef, 0x08, 0xc1, 0x62, 0x8a, 0x5c, 0x52, 0xa8, 0xae,
+	0x54, 0x86, 0xec, 0xc8, 0x12, 0xff, 0x51, 0x4b, 0xed, 0x2d, 0x6c, 0x9c, 0xe8, 0x9d, 0x2b, 0x1a,
+	0xe3, 0x55, 0xd8, 0x41, 0xd6, 0x25, 0xf4, 0x15, 0xe4, 0x6f, 0xdb, 0x88, 0x2d, 0xdc, 0x0f, 0x4c,
+	0x8a, 0x6c, 0x91, 0xf0, 0x66, 0xca, 0x16, 0xee, 0x2b, 0x39, 0x12, 0x39, 0x11, 0x5a, 0xfb, 0x81,
+	0x8b, 0x5f, 0x6b, 0xf1, 0x0f, 0x88, 0x1c, 0x95, 0x86, 0x5e, 0x4a, 0x1a, 0x14, 0xd9, 0x57, 0xc0,
+	0xa8, 0xdf, 0x48, 0xac, 0xa9, 0x5f, 0x04, 0x94, 0x50, 0xc1, 0xa5, 0xe8, 0xca, 0xcc, 0x85, 0x52,
+	0xf6, 0x2a, 0xa8, 0x85, 0xaf, 0x82, 0x74, 0x17, 0xe3, 0xa6, 0x84, 0x24, 0x53, 0xbf, 0x86, 0xbd,
+	0xbb, 0x4c, 0xf9, 0x8b, 0xe1, 0x0b, 0x58, 0x8c, 0x14, 0x98, 0xbf, 0xbe, 0xc7, 0x9a, 0x10, 0xc5,
+	0x94, 0x3e, 0x85, 0xdc, 0x29, 0xf6, 0xd8, 0x2a, 0x6e, 0x38, 0x06, 0x26, 0x2c, 0x6d, 0x9d, 0xf4,
+	0xb0, 0x5f, 0xf0, 0xac, 0xc2, 0x4f, 0xa5, 0x03, 0x40, 0x23, 0x40, 0xdf, 0xe3, 0x2a, 0xcc, 0xd1,
+	0x8f, 0xd2, 0x00, 0xec, 0x1f, 0x0e, 0xfe, 0x3b, 0x0b, 0x8b, 0x91, 0x2f, 0x44, 0xf4, 0x29, 0x3c,
+	0x7a, 0x21, 0xab, 0xaa, 0x74, 0x2a, 0x6b, 0xad, 0x97, 0xe7, 0xb2, 0x76, 0x5e, 0x97, 0xca, 0x72,
+	0xb5, 0x59, 0xaf, 0xc8, 0x8a, 0x56, 0x69, 0x6a, 0x8d, 0x66, 0x4b, 0xbb, 0x50, 0x65, 0x71, 0x0a,
+	0xdd, 0x83, 0xd9, 0xcb, 0x66, 0x4b, 0x16, 0x05, 0x74, 0x1f, 0xd6, 0x6a, 0x5a, 0x55, 0xba, 0x94,
+	0xb5, 0x93, 0x7a, 0xb3, 0x7c, 0xa6, 0x9d, 0x2b, 0xcd, 0xf3, 0xa6, 0x2a, 0xd5, 0xc5, 0x69, 0xb4,
+	0x09, 0xeb, 0x8a, 0xfc, 0xab, 0x0b, 0x59, 0x6d, 0xc5, 0x75, 0x33, 0x68, 0x07, 0x1e, 0x26, 0xeb,
+	0x34, 0x45, 0x3e, 0xaf, 0xbf, 0x14, 0x67, 0xd1, 0x06, 0xac, 0x9c, 0xca, 0x2d, 0xad, 0xdc, 0x6c,
+	0xa8, 0x72, 0x43, 0xbd, 0x50, 0x35, 0xb5, 0x25, 0xb5, 0x64, 0x71, 0x0e, 0x6d, 0xc1, 0xfd, 0x04,
+	0x05, 0xbf, 0x37, 0x8f, 0xd6, 0x60, 0xf9, 0x54, 0x0e, 0xac, 0x56, 0x65, 0xa9, 0x22, 0x2b, 0xaa,
+	0xb8, 0x80, 0x1e, 0xc0, 0xc6, 0x98, 0x98, 0xdf, 0xb9, 0x87, 0x96, 0x00, 0x42, 0xa5, 0x2a, 0x66,
+	0xd0, 0x2a, 0x88, 0xb7, 0x67, 0x8e, 0x02, 0x94, 0x81, 0x39, 0x45, 0xae, 0x4b, 0x2f, 0xc5, 0x45,
+	0x24, 0x42, 0xb6, 0xa5, 0x48, 0x0d, 0x55, 0x2a, 0xb7, 0x6a, 0xcd, 0x86, 0x2a, 0x66, 0x69, 0x54,
+	0x27, 0x52, 0xf9, 0xac, 0xa5, 0x48, 0xe5, 0x33, 0x4d, 0xad, 0x9d, 0x36, 0xa4, 0xd6, 0x85, 0x22,
+	0x6b, 0xe5, 0xaa, 0x54, 0x6b, 0x88, 0x39, 0xb4, 0x0b, 0x5b, 0x41, 0xbe, 0x61, 0xa6, 0x23, 0x16,
+	0x96, 0x68, 0xf1, 0x27, 0x42, 0x78, 0x1c, 0x79, 0xf4, 0x18, 0x4a, 0xbc, 0xe4, 0x31, 0x3f, 0x51,
+	0xb8, 0x28, 0x46, 0x0d, 0x4e, 0x02, 0x2e, 0xa3, 0xcf, 0xe1, 0xc9, 0x0f, 0x00, 0x72, 0xff, 0x08,
+	0xad, 0x40, 0x9e, 0x56, 0xc7, 0x2f, 0x7b, 0xa3, 0x59, 0x91, 0x55, 0x71, 0x85, 0xce, 0x41, 0x4c,
+	0xc8, 0xf1, 0xab, 0x68, 0x1d, 0x10, 0xab, 0x9b, 0x56, 0x91, 0xeb, 0xb5, 0x4b, 0x59, 0x79, 0xa9,
+	0x49, 0xe5, 0x33, 0x71, 0xed, 0xa0, 0x0c, 0x05, 0xc9, 0xb2, 0x9c, 0xdf, 0x62, 0x63, 0xe4, 0xf7,
+	0x46, 0x30, 0x89, 0x52, 0xbd, 0xde, 0xfc, 0x96, 0x05, 0x24, 0x57, 0x52, 0x27, 0xf1, 0xe0, 0x6f,
+	0x0b, 0xb0, 0xc9, 0xad, 0xc4, 0x7e, 0x16, 0x31, 0x3b, 0x4f, 0x60, 0xcf, 0xb7, 0x73, 0xd1, 0xb8,
+	0xc3, 0x12, 0x1d, 0xb8, 0x18, 0x94, 0x8f, 0xf8, 0x3e, 0x7c, 0x12, 0x53, 0xa4, 0x4d, 0xfc, 0xb8,
+	0xb7, 0x54, 0x02, 0x3c, 0x86, 0xd2, 0x44, 0x68, 0x40, 0x83, 0x71, 0x5c, 0x32, 0x2b, 0x9e, 0xc2,
+	0xfe, 0xdd, 0xb8, 0x90, 0x24, 0x9f, 0xc0, 0x4e, 0x02, 0x3a, 0xce, 0x99, 0x03, 0x78, 0x7c, 0x17,
+	0x2a, 0xa4, 0xd0, 0x16, 0xdc, 0x4f, 0xc3, 0x52, 0x46, 0x3d, 0x82, 0xed, 0x54, 0x75, 0x48, 0xb0,
+	0x02, 0xac, 0x8e, 0xd5, 0xc4, 0xe7, 0xdb, 0x36, 0x3c, 0x88, 0x69, 0x62, 0xf4, 0x1b, 0x4f, 0x7f,
+	0x12, 0x1b, 0x9f, 0xc1, 0xd3, 0x94, 0xe2, 0xa7, 0x91, 0xf3, 0x4b, 0x38, 0xfe, 0x98, 0x1b, 0x21,
+	0x57, 0x7f, 0x0e, 0x5f, 0x24, 0xcf, 0xce, 0x64, 0xea, 0xa6, 0xbb, 0x9b, 0xcc, 0xe4, 0xaf, 0xe1,
+	0xab, 0x8f, 0xbf, 0x17, 0x12, 0xbb, 0x04, 0xc5, 0x84, 0x26, 0x8d, 0xf2, 0x7c, 0x9c, 0x0c, 0x69,
+	0xb4, 0xdf, 0x83, 0xdd, 0xa4, 0x6e, 0xc6, 0xb7, 0xc0, 0x9f, 0xe7, 0xc3, 0x35, 0x50, 0x31, 0x5d,
+	0xdc, 0xf1, 0x12, 0xd7, 0x40, 0xa5, 0xa6, 0xc8, 0xe5, 0x56, 0x3a, 0x79, 0xd7, 0x60, 0x79, 0x04,
+	0xc8, 0xa9, 0x1b, 0xb2, 0x87, 0x8b, 0xd3, 0x88, 0x1b, 0xf7, 0x93, 0x4a, 0xdb, 0x90, 0x38, 0x89,
+	0xc0, 0x80, 0xb4, 0x71, 0x54, 0x32, 0x65, 0x43, 0x7a, 0xa5, 0xa3, 0x42, 0xc2, 0x86, 0xad, 0x89,
+	0x60, 0xe3, 0x74, 0x0d, 0x5b, 0x93, 0x86, 0x09, 0xc9, 0xfa, 0x00, 0x36, 0x92, 0x91, 0x94, 0xaa,
+	0xbb, 0xb0, 0x95, 0xa2, 0x0c, 0x89, 0x1a, 0x8f, 0x7c, 0x12, 0xd7, 0x0e, 0xe1, 0x20, 0xb1, 0x62,
+	0x69, 0x4c, 0xfb, 0x19, 0x3c, 0xfb, 0xe1, 0xf8, 0x90, 0x67, 0xcf, 0xe1, 0x28, 0xa9, 0xd1, 0x93,
+	0x59, 0x96, 0xe6, 0x6a, 0x32, 0xc7, 0x7e, 0x01, 0x5f, 0x7e, 0xec, 0xad, 0x90, 0x61, 0x3b, 0xf0,
+	0x70, 0xac, 0xb6, 0xa3, 0xfc, 0x8a, 0x4f, 0x6c, 0x0a, 0xbb, 0x0e, 0x7e, 0x07, 0x1b, 0x9c, 0x35,
+	0xec, 0xd7, 0x42, 0x94, 0x34, 0xa1, 0x09, 0x9f, 0x6f, 0x77, 0x73, 0xc6, 0xc7, 0x05, 0xbb, 0x36,
+	0x1c, 0xb5, 0x88, 0x38, 0x4e, 0xda, 0x21, 0x6c, 0x73, 0xef, 0x27, 0xae, 0xa3, 0x1b, 0x1d, 0x9d,
+	0x78, 0xe7, 0xd7, 0xa4, 0x17, 0x8d, 0xe2, 0x08, 0x3e, 0xf3, 0xcd, 0x9c, 0x28, 0x4d, 0xa9, 0x52,
+	0x96, 0x68, 0x0b, 0x2f, 0xd4, 0x6a, 0x7a, 0x38, 0xe1, 0xbe, 0x88, 0x5d, 0x18, 0xdd, 0xf4, 0x07,
+	0x4a, 0x92, 0x6b, 0xcb, 0xba, 0xd3, 0x75, 0xbd, 0x9e, 0xfe, 0x11, 0x91, 0x90, 0x4e, 0xcb, 0xc5,
+	0xf8, 0x0e, 0x9b, 0x2d, 0x45, 0x96, 0x3f, 0x2a, 0x1d, 0x76, 0x21, 0x96, 0xce, 0x1b, 0x58, 0x4f,
+	0xfe, 0x9b, 0x0c, 0x3d, 0x84, 0x42, 0x30, 0x5a, 0xdf, 0xd0, 0xe8, 0xa3, 0x53, 0x38, 0x15, 0xd5,
+	0x46, 0x14, 0x5a, 0x55, 0x52, 0xab, 0xa2, 0x40, 0x7b, 0x98, 0xa4, 0x55, 0xab, 0x4d, 0xa5, 0xe5,
+	0x63, 0xa6, 0x4f, 0xbe, 0x7e, 0xf7, 0xbe, 0x38, 0xf5, 0xdd, 0xfb, 0xe2, 0xd4, 0xf7, 0xef, 0x8b,
+	0xc2, 0x7f, 0xde, 0x17, 0x85, 0xdf, 0xdf, 0x14, 0x85, 0x3f, 0xdd, 0x14, 0x85, 0xbf, 0xde, 0x14,
+	0x85, 0xbf, 0xdf, 0x14, 0x85, 0x77, 0x37, 0x45, 0xe1, 0x1f, 0x37, 0x45, 0xe1, 0x9f, 0x37, 0xc5,
+	0xa9, 0xef, 0x6f, 0x8a, 0xc2, 0x1f, 0x3f, 0x14, 0xa7, 0xde, 0x7d, 0x28, 0x4e, 0x7d, 0xf7, 0xa1,
+	0x38, 0xd5, 0x9e, 0x67, 0xff, 0x46, 0x3f, 0xff, 0xdf, 0x00, 0xdb, 0xcf, 0x74, 0xd8, 0x38, 0x17,
+	0x00, 0x00,
 }
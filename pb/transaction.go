@@ -82,11 +82,15 @@ func (m *Coinbase) ToMap() map[string]interface{} {
 }
 
 func (m *TransferAsset) ToMap() map[string]interface{} {
-	return map[string]interface{}{
+	ret := map[string]interface{}{
 		"sender":    common.BytesToUint160(m.Sender),
 		"recipient": common.BytesToUint160(m.Recipient),
 		"amount":    m.Amount,
 	}
+	if len(m.AssetId) > 0 {
+		ret["assetID"] = common.HexStr(m.AssetId)
+	}
+	return ret
 }
 
 func (m *GenerateID) ToMap() map[string]interface{} {
@@ -103,6 +107,14 @@ func (m *RegisterName) ToMap() map[string]interface{} {
 	}
 }
 
+func (m *TransferName) ToMap() map[string]interface{} {
+	return map[string]interface{}{
+		"registrant": common.HexStr(m.Registrant),
+		"recipient":  common.HexStr(m.Recipient),
+		"name":       m.Name,
+	}
+}
+
 func (m *Subscribe) ToMap() map[string]interface{} {
 	return map[string]interface{}{
 		"subscriber": common.HexStr(m.Subscriber),
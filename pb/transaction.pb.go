@@ -455,6 +455,65 @@ func (m *RegisterName) GetName() string {
 	return ""
 }
 
+type TransferName struct {
+	Registrant []byte `protobuf:"bytes,1,opt,name=registrant,proto3" json:"registrant,omitempty"`
+	Recipient  []byte `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Name       string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *TransferName) Reset()      { *m = TransferName{} }
+func (*TransferName) ProtoMessage() {}
+func (*TransferName) Descriptor() ([]byte, []int) {
+	return fileDescriptor_489dcea0c2b7da12, []int{7}
+}
+func (m *TransferName) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferName.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferName) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferName.Merge(m, src)
+}
+func (m *TransferName) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferName) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferName.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferName proto.InternalMessageInfo
+
+func (m *TransferName) GetRegistrant() []byte {
+	if m != nil {
+		return m.Registrant
+	}
+	return nil
+}
+
+func (m *TransferName) GetRecipient() []byte {
+	if m != nil {
+		return m.Recipient
+	}
+	return nil
+}
+
+func (m *TransferName) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 type DeleteName struct {
 	Registrant []byte `protobuf:"bytes,1,opt,name=registrant,proto3" json:"registrant,omitempty"`
 	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -463,7 +522,7 @@ type DeleteName struct {
 func (m *DeleteName) Reset()      { *m = DeleteName{} }
 func (*DeleteName) ProtoMessage() {}
 func (*DeleteName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_489dcea0c2b7da12, []int{7}
+	return fileDescriptor_489dcea0c2b7da12, []int{8}
 }
 func (m *DeleteName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +577,7 @@ type Subscribe struct {
 func (m *Subscribe) Reset()      { *m = Subscribe{} }
 func (*Subscribe) ProtoMessage() {}
 func (*Subscribe) Descriptor() ([]byte, []int) {
-	return fileDescriptor_489dcea0c2b7da12, []int{8}
+	return fileDescriptor_489dcea0c2b7da12, []int{9}
 }
 func (m *Subscribe) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,7 +658,7 @@ type Unsubscribe struct {
 func (m *Unsubscribe) Reset()      { *m = Unsubscribe{} }
 func (*Unsubscribe) ProtoMessage() {}
 func (*Unsubscribe) Descriptor() ([]byte, []int) {
-	return fileDescriptor_489dcea0c2b7da12, []int{9}
+	return fileDescriptor_489dcea0c2b7da12, []int{10}
 }
 func (m *Unsubscribe) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -653,12 +712,13 @@ type TransferAsset struct {
 	Sender    []byte `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Recipient []byte `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
 	Amount    int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	AssetId   []byte `protobuf:"bytes,4,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
 }
 
 func (m *TransferAsset) Reset()      { *m = TransferAsset{} }
 func (*TransferAsset) ProtoMessage() {}
 func (*TransferAsset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_489dcea0c2b7da12, []int{10}
+	return fileDescriptor_489dcea0c2b7da12, []int{11}
 }
 func (m *TransferAsset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -708,6 +768,13 @@ func (m *TransferAsset) GetAmount() int64 {
 	return 0
 }
 
+func (m *TransferAsset) GetAssetId() []byte {
+	if m != nil {
+		return m.AssetId
+	}
+	return nil
+}
+
 type GenerateID struct {
 	PublicKey       []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	RegistrationFee int64  `protobuf:"varint,2,opt,name=registration_fee,json=registrationFee,proto3" json:"registration_fee,omitempty"`
@@ -716,7 +783,7 @@ type GenerateID struct {
 func (m *GenerateID) Reset()      { *m = GenerateID{} }
 func (*GenerateID) ProtoMessage() {}
 func (*GenerateID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_489dcea0c2b7da12, []int{11}
+	return fileDescriptor_489dcea0c2b7da12, []int{12}
 }
 func (m *GenerateID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -771,7 +838,7 @@ type NanoPay struct {
 func (m *NanoPay) Reset()      { *m = NanoPay{} }
 func (*NanoPay) ProtoMessage() {}
 func (*NanoPay) Descriptor() ([]byte, []int) {
-	return fileDescriptor_489dcea0c2b7da12, []int{12}
+	return fileDescriptor_489dcea0c2b7da12, []int{13}
 }
 func (m *NanoPay) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -853,7 +920,7 @@ type IssueAsset struct {
 func (m *IssueAsset) Reset()      { *m = IssueAsset{} }
 func (*IssueAsset) ProtoMessage() {}
 func (*IssueAsset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_489dcea0c2b7da12, []int{13}
+	return fileDescriptor_489dcea0c2b7da12, []int{14}
 }
 func (m *IssueAsset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -926,6 +993,7 @@ func init() {
 	proto.RegisterType((*Coinbase)(nil), "pb.Coinbase")
 	proto.RegisterType((*SigChainTxn)(nil), "pb.SigChainTxn")
 	proto.RegisterType((*RegisterName)(nil), "pb.RegisterName")
+	proto.RegisterType((*TransferName)(nil), "pb.TransferName")
 	proto.RegisterType((*DeleteName)(nil), "pb.DeleteName")
 	proto.RegisterType((*Subscribe)(nil), "pb.Subscribe")
 	proto.RegisterType((*Unsubscribe)(nil), "pb.Unsubscribe")
@@ -938,64 +1006,65 @@ func init() {
 func init() { proto.RegisterFile("pb/transaction.proto", fileDescriptor_489dcea0c2b7da12) }
 
 var fileDescriptor_489dcea0c2b7da12 = []byte{
-	// 904 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xb1, 0x8f, 0xe3, 0xc4,
-	0x17, 0xc7, 0x33, 0xd9, 0x6c, 0xb2, 0x79, 0xc9, 0xe6, 0xf6, 0xe6, 0x56, 0xf7, 0x8b, 0x56, 0x3f,
-	0xac, 0x60, 0x74, 0x62, 0x41, 0x22, 0x2b, 0x1d, 0x25, 0x14, 0x24, 0x59, 0x5f, 0x88, 0x00, 0xdf,
-	0x6a, 0xec, 0xa0, 0xbb, 0x02, 0x59, 0x63, 0x67, 0x36, 0x37, 0xba, 0x64, 0x6c, 0xd9, 0x63, 0x29,
-	0x11, 0xcd, 0xfd, 0x09, 0xf0, 0x5f, 0xd0, 0xd0, 0x53, 0x51, 0x53, 0x6e, 0x79, 0x25, 0x9b, 0x6d,
-	0x28, 0xaf, 0xa4, 0x44, 0x33, 0x9e, 0x24, 0x06, 0x21, 0x8a, 0x13, 0x74, 0xf3, 0xfd, 0xbc, 0xe7,
-	0x97, 0xef, 0x7b, 0xf3, 0xec, 0xc0, 0x69, 0x12, 0x5e, 0xc8, 0x94, 0x8a, 0x8c, 0x46, 0x92, 0xc7,
-	0xa2, 0x9f, 0xa4, 0xb1, 0x8c, 0x71, 0x35, 0x09, 0xcf, 0x3e, 0x9a, 0x73, 0xf9, 0x22, 0x0f, 0xfb,
-	0x51, 0xbc, 0xbc, 0x98, 0xc7, 0xf3, 0xf8, 0x42, 0x87, 0xc2, 0xfc, 0x5a, 0x2b, 0x2d, 0xf4, 0xa9,
-	0x78, 0xc4, 0xfe, 0x16, 0x60, 0x2a, 0x32, 0x3e, 0x17, 0x6c, 0xe6, 0xaf, 0xf0, 0x23, 0x68, 0x24,
-	0x74, 0xbd, 0x88, 0xe9, 0xac, 0x8b, 0x7a, 0xe8, 0xbc, 0xf5, 0xb8, 0xd5, 0x4f, 0xc2, 0xfe, 0x55,
-	0x81, 0xc8, 0x36, 0x86, 0x4f, 0xe1, 0x50, 0xc4, 0x22, 0x62, 0xdd, 0x6a, 0x0f, 0x9d, 0xd7, 0x48,
-	0x21, 0xf0, 0x09, 0x1c, 0x5c, 0x33, 0xd6, 0x3d, 0xe8, 0xa1, 0xf3, 0x03, 0xa2, 0x8e, 0xd8, 0x02,
-	0xa0, 0x52, 0xa6, 0x3c, 0xcc, 0x25, 0xcb, 0xba, 0xb5, 0x1e, 0x3a, 0x6f, 0x93, 0x12, 0xb1, 0xe7,
-	0xd0, 0xf2, 0xf7, 0x4d, 0xe0, 0x0b, 0x68, 0xe5, 0xc6, 0x4b, 0x20, 0x57, 0xc6, 0x41, 0x47, 0x39,
-	0xd8, 0x5b, 0x24, 0x90, 0xef, 0xed, 0xbe, 0x0f, 0x47, 0x49, 0x1a, 0xcf, 0x53, 0xba, 0xcc, 0xba,
-	0xd5, 0xde, 0xc1, 0xce, 0x6f, 0xc1, 0xc8, 0x2e, 0x68, 0x7f, 0x02, 0x0d, 0x03, 0x31, 0x86, 0x5a,
-	0x14, 0xcf, 0x98, 0xae, 0xde, 0x26, 0xfa, 0x8c, 0xff, 0x0f, 0xcd, 0x84, 0xa6, 0x74, 0xc9, 0x24,
-	0x4b, 0x75, 0x4f, 0x6d, 0xb2, 0x07, 0xf6, 0x10, 0x1a, 0x66, 0x02, 0xf8, 0x3d, 0xa8, 0xc9, 0x75,
-	0x52, 0x3c, 0xdc, 0x79, 0x7c, 0xaf, 0x34, 0x1c, 0x7f, 0x9d, 0x30, 0xa2, 0x83, 0xea, 0x17, 0x66,
-	0x54, 0x52, 0x53, 0x48, 0x9f, 0xed, 0x67, 0x70, 0x34, 0x8a, 0xb9, 0x08, 0x69, 0xc6, 0xf0, 0x43,
-	0xa8, 0x67, 0x4c, 0xcc, 0x58, 0x6a, 0x3c, 0x18, 0xa5, 0x5c, 0xa4, 0x2c, 0xe2, 0x09, 0x67, 0x42,
-	0x6e, 0x5d, 0xec, 0x80, 0x7a, 0x8a, 0x2e, 0xe3, 0x5c, 0x48, 0x33, 0x60, 0xa3, 0xec, 0x31, 0xb4,
-	0x3c, 0x3e, 0x1f, 0xbd, 0xa0, 0x5c, 0xf8, 0x2b, 0x81, 0xcf, 0xe0, 0x28, 0x33, 0xd2, 0x94, 0xdf,
-	0x69, 0xf5, 0x03, 0x59, 0x1e, 0x2e, 0xb9, 0x2c, 0xb5, 0xb9, 0x03, 0xf6, 0x10, 0xda, 0x84, 0xcd,
-	0x79, 0x26, 0x59, 0xea, 0xd2, 0xa5, 0xbe, 0xbc, 0x54, 0xeb, 0x94, 0x0a, 0x69, 0x6a, 0x95, 0x88,
-	0x6a, 0x53, 0xd0, 0x65, 0xb1, 0x03, 0x4d, 0xa2, 0xcf, 0xf6, 0x67, 0x00, 0x97, 0x6c, 0xc1, 0x24,
-	0x7b, 0xeb, 0x0a, 0x3f, 0x22, 0x68, 0x7a, 0x79, 0x98, 0x45, 0x29, 0x0f, 0x75, 0x85, 0x6c, 0x2b,
-	0xb6, 0xe3, 0x2a, 0x11, 0x15, 0xe7, 0x33, 0x26, 0x24, 0xbf, 0xe6, 0xa6, 0xa5, 0x26, 0x29, 0x11,
-	0xb5, 0xa8, 0x32, 0x4e, 0x78, 0xa4, 0x67, 0xd6, 0x24, 0x85, 0xc0, 0x67, 0x50, 0x0f, 0xf3, 0xe8,
-	0x25, 0x93, 0x7a, 0x25, 0x8f, 0x87, 0xd5, 0x2e, 0x22, 0x86, 0xa8, 0xf9, 0xcd, 0xf2, 0x94, 0xaa,
-	0x7d, 0xec, 0x1e, 0xaa, 0x28, 0xd9, 0x69, 0xe5, 0x77, 0xc9, 0x24, 0xed, 0xd6, 0x0b, 0xbf, 0xea,
-	0x6c, 0x47, 0xd0, 0x9a, 0x8a, 0xec, 0xbf, 0x35, 0x6c, 0x7f, 0x03, 0xc7, 0xfa, 0x3d, 0xb9, 0x66,
-	0xe9, 0x20, 0xcb, 0x98, 0xfc, 0x97, 0x57, 0xe8, 0x6b, 0x80, 0x31, 0x13, 0x2c, 0xa5, 0x92, 0x4d,
-	0x2e, 0xf1, 0x3b, 0x00, 0x49, 0x1e, 0x2e, 0x78, 0x14, 0xbc, 0x64, 0x6b, 0x53, 0xbf, 0x59, 0x90,
-	0x2f, 0xd8, 0x1a, 0x7f, 0x00, 0x27, 0xdb, 0x2b, 0x54, 0x43, 0x09, 0xd4, 0x2b, 0x5f, 0xd5, 0xe5,
-	0xee, 0x95, 0xf9, 0x13, 0xc6, 0xec, 0x9f, 0x11, 0x34, 0x5c, 0x2a, 0xe2, 0x2b, 0xba, 0x7e, 0x4b,
-	0xc7, 0x1d, 0xa8, 0xf2, 0x99, 0x76, 0x5b, 0x23, 0x55, 0x3e, 0x2b, 0x75, 0x50, 0x2b, 0x77, 0x80,
-	0x1f, 0x41, 0x47, 0xae, 0x44, 0xc0, 0x56, 0x09, 0xff, 0xd3, 0xdd, 0x1d, 0xcb, 0x95, 0x70, 0x76,
-	0x10, 0xf7, 0xe1, 0x81, 0xa0, 0x22, 0x0e, 0x12, 0xba, 0x2e, 0xe7, 0xd6, 0x75, 0xee, 0x7d, 0x51,
-	0x58, 0xdd, 0xe7, 0xdb, 0xdf, 0x23, 0x80, 0x49, 0x96, 0xe5, 0xec, 0x9f, 0xa7, 0xfe, 0x37, 0x7b,
-	0xac, 0x73, 0xd7, 0xcb, 0x30, 0x5e, 0x98, 0x9b, 0x34, 0x0a, 0xbf, 0x0b, 0x6d, 0x19, 0x4b, 0xba,
-	0x08, 0xb2, 0x3c, 0x49, 0x16, 0x6b, 0xd3, 0x47, 0x4b, 0x33, 0x4f, 0x23, 0xfd, 0x35, 0x52, 0x23,
-	0xc8, 0xf6, 0x7d, 0xec, 0xc1, 0x87, 0xaf, 0xaa, 0xd0, 0x2a, 0x7d, 0x73, 0xf0, 0x7d, 0x38, 0x1e,
-	0x3d, 0x9d, 0xb8, 0xc3, 0x81, 0xe7, 0x04, 0xfe, 0xf3, 0x2b, 0xe7, 0xa4, 0x82, 0xff, 0x07, 0x0f,
-	0x7c, 0x32, 0x70, 0xbd, 0x27, 0x0e, 0x09, 0x06, 0x9e, 0xe7, 0xf8, 0x45, 0x00, 0xe1, 0x87, 0x80,
-	0xbd, 0xc9, 0x38, 0x18, 0x7d, 0x3e, 0x98, 0xb8, 0x81, 0xff, 0xcc, 0x2d, 0x78, 0x55, 0x71, 0xe2,
-	0x8c, 0x27, 0x9e, 0xef, 0x90, 0xc0, 0x1d, 0x7c, 0x65, 0x0a, 0x1d, 0x28, 0xbe, 0x2b, 0xb4, 0xe7,
-	0x35, 0x7c, 0x0a, 0x27, 0x97, 0xce, 0x97, 0x8e, 0xef, 0x94, 0xe8, 0x21, 0xc6, 0xd0, 0xf1, 0xa6,
-	0x43, 0x6f, 0x44, 0x26, 0x43, 0xc3, 0xea, 0x2a, 0x73, 0xea, 0xfe, 0x85, 0x36, 0x14, 0x1d, 0x3b,
-	0xae, 0x43, 0x06, 0xbe, 0x13, 0x4c, 0x2e, 0x0b, 0x7a, 0xa4, 0x3a, 0x71, 0x07, 0xee, 0xd3, 0xe0,
-	0x6a, 0xf0, 0xbc, 0x40, 0x4d, 0x95, 0x38, 0xf1, 0xbc, 0xa9, 0x53, 0x6e, 0x03, 0x86, 0x9f, 0xde,
-	0xdc, 0x5a, 0x95, 0xd7, 0xb7, 0x56, 0xe5, 0xcd, 0xad, 0x85, 0x7e, 0xbf, 0xb5, 0xd0, 0xab, 0x8d,
-	0x85, 0x7e, 0xd8, 0x58, 0xe8, 0xa7, 0x8d, 0x85, 0x7e, 0xd9, 0x58, 0xe8, 0x66, 0x63, 0xa1, 0x5f,
-	0x37, 0x16, 0xfa, 0x6d, 0x63, 0x55, 0xde, 0x6c, 0x2c, 0xf4, 0xdd, 0x9d, 0x55, 0xb9, 0xb9, 0xb3,
-	0x2a, 0xaf, 0xef, 0xac, 0x4a, 0x58, 0xd7, 0x7f, 0x7c, 0x1f, 0xff, 0x11, 0x00, 0x00, 0xff, 0xff,
-	0x06, 0x54, 0xaf, 0x89, 0x43, 0x07, 0x00, 0x00,
+	// 928 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x41, 0x8f, 0xdb, 0xc4,
+	0x1b, 0xc6, 0xe3, 0x24, 0x9b, 0x6c, 0xde, 0x64, 0xb7, 0xdb, 0xe9, 0xaa, 0xff, 0xfc, 0x57, 0x60,
+	0x2d, 0x46, 0x15, 0x0b, 0x12, 0x59, 0xa9, 0x1c, 0xe1, 0x40, 0x92, 0x75, 0x83, 0x05, 0xb8, 0xab,
+	0xb1, 0x83, 0xda, 0x93, 0x19, 0xdb, 0xb3, 0xe9, 0xa8, 0xc9, 0xd8, 0xb2, 0xc7, 0x52, 0x22, 0x2e,
+	0xfd, 0x08, 0xf0, 0x2d, 0xb8, 0x70, 0xe7, 0xc4, 0x99, 0xe3, 0x1e, 0x7b, 0x64, 0xb3, 0x17, 0x8e,
+	0x3d, 0x72, 0x44, 0x33, 0x9e, 0x24, 0x2e, 0x42, 0x15, 0xaa, 0xe0, 0x36, 0xcf, 0x6f, 0xc6, 0xef,
+	0x3c, 0xcf, 0xbc, 0x93, 0x09, 0x1c, 0xa7, 0xe1, 0xb9, 0xc8, 0x08, 0xcf, 0x49, 0x24, 0x58, 0xc2,
+	0x07, 0x69, 0x96, 0x88, 0x04, 0xd5, 0xd3, 0xf0, 0xe4, 0xe3, 0x19, 0x13, 0xcf, 0x8a, 0x70, 0x10,
+	0x25, 0x8b, 0xf3, 0x59, 0x32, 0x4b, 0xce, 0xd5, 0x54, 0x58, 0x5c, 0x29, 0xa5, 0x84, 0x1a, 0x95,
+	0x9f, 0x58, 0xdf, 0x01, 0x4c, 0x79, 0xce, 0x66, 0x9c, 0xc6, 0xfe, 0x12, 0x3d, 0x80, 0x76, 0x4a,
+	0x56, 0xf3, 0x84, 0xc4, 0x7d, 0xe3, 0xd4, 0x38, 0xeb, 0x3e, 0xec, 0x0e, 0xd2, 0x70, 0x70, 0x59,
+	0x22, 0xbc, 0x99, 0x43, 0xc7, 0xb0, 0xc7, 0x13, 0x1e, 0xd1, 0x7e, 0xfd, 0xd4, 0x38, 0x6b, 0xe2,
+	0x52, 0xa0, 0x23, 0x68, 0x5c, 0x51, 0xda, 0x6f, 0x9c, 0x1a, 0x67, 0x0d, 0x2c, 0x87, 0xc8, 0x04,
+	0x20, 0x42, 0x64, 0x2c, 0x2c, 0x04, 0xcd, 0xfb, 0xcd, 0x53, 0xe3, 0xac, 0x87, 0x2b, 0xc4, 0x9a,
+	0x41, 0xd7, 0xdf, 0x85, 0x40, 0xe7, 0xd0, 0x2d, 0xb4, 0x97, 0x40, 0x2c, 0xb5, 0x83, 0x43, 0xe9,
+	0x60, 0x67, 0x11, 0x43, 0xb1, 0xb3, 0xfb, 0x01, 0xec, 0xa7, 0x59, 0x32, 0xcb, 0xc8, 0x22, 0xef,
+	0xd7, 0x4f, 0x1b, 0x5b, 0xbf, 0x25, 0xc3, 0xdb, 0x49, 0xeb, 0x53, 0x68, 0x6b, 0x88, 0x10, 0x34,
+	0xa3, 0x24, 0xa6, 0xaa, 0x7a, 0x0f, 0xab, 0x31, 0x7a, 0x07, 0x3a, 0x29, 0xc9, 0xc8, 0x82, 0x0a,
+	0x9a, 0xa9, 0x4c, 0x3d, 0xbc, 0x03, 0xd6, 0x08, 0xda, 0xfa, 0x04, 0xd0, 0xfb, 0xd0, 0x14, 0xab,
+	0xb4, 0xfc, 0xf8, 0xf0, 0xe1, 0x9d, 0xca, 0xe1, 0xf8, 0xab, 0x94, 0x62, 0x35, 0x29, 0x77, 0x88,
+	0x89, 0x20, 0xba, 0x90, 0x1a, 0x5b, 0x4f, 0x60, 0x7f, 0x9c, 0x30, 0x1e, 0x92, 0x9c, 0xa2, 0xfb,
+	0xd0, 0xca, 0x29, 0x8f, 0x69, 0xa6, 0x3d, 0x68, 0x25, 0x5d, 0x64, 0x34, 0x62, 0x29, 0xa3, 0x5c,
+	0x6c, 0x5c, 0x6c, 0x81, 0xfc, 0x8a, 0x2c, 0x92, 0x82, 0x0b, 0x7d, 0xc0, 0x5a, 0x59, 0x13, 0xe8,
+	0x7a, 0x6c, 0x36, 0x7e, 0x46, 0x18, 0xf7, 0x97, 0x1c, 0x9d, 0xc0, 0x7e, 0xae, 0xa5, 0x2e, 0xbf,
+	0xd5, 0x72, 0x83, 0xbc, 0x08, 0x17, 0x4c, 0x54, 0x62, 0x6e, 0x81, 0x35, 0x82, 0x1e, 0xa6, 0x33,
+	0x96, 0x0b, 0x9a, 0xb9, 0x64, 0xa1, 0x9a, 0x97, 0x29, 0x9d, 0x11, 0x2e, 0x74, 0xad, 0x0a, 0x91,
+	0x31, 0x39, 0x59, 0x94, 0x77, 0xa0, 0x83, 0xd5, 0xd8, 0xfa, 0x16, 0x7a, 0xaa, 0xa1, 0x57, 0xff,
+	0xb0, 0xc6, 0x9b, 0x23, 0x6f, 0x76, 0x68, 0x54, 0x76, 0xf8, 0x1c, 0xe0, 0x82, 0xce, 0xa9, 0xa0,
+	0x6f, 0xed, 0xf1, 0x27, 0x03, 0x3a, 0x5e, 0x11, 0xe6, 0x51, 0xc6, 0x42, 0x55, 0x21, 0xdf, 0x88,
+	0x4d, 0x43, 0x2a, 0x44, 0xce, 0xb3, 0x98, 0x72, 0xc1, 0xae, 0x98, 0x3e, 0xb4, 0x0e, 0xae, 0x10,
+	0xf9, 0x53, 0x10, 0x49, 0xca, 0x22, 0x6d, 0xb2, 0x14, 0xe8, 0x04, 0x5a, 0x61, 0x11, 0x3d, 0xa7,
+	0x42, 0x5d, 0xfa, 0x83, 0x51, 0xbd, 0x6f, 0x60, 0x4d, 0x64, 0x87, 0xe2, 0x22, 0x23, 0xf2, 0xc6,
+	0xf7, 0xf7, 0xe4, 0x2c, 0xde, 0x6a, 0xe9, 0x77, 0x41, 0x05, 0xe9, 0xb7, 0x4a, 0xbf, 0x72, 0x6c,
+	0x45, 0xd0, 0x9d, 0xf2, 0xfc, 0xbf, 0x35, 0x6c, 0x2d, 0xe1, 0x60, 0xd3, 0xb8, 0x61, 0x9e, 0x53,
+	0xf1, 0xef, 0x5e, 0x52, 0xf4, 0x7f, 0xd8, 0x27, 0xb2, 0x6c, 0xc0, 0x62, 0xfd, 0x0c, 0xb4, 0x95,
+	0x76, 0x62, 0xeb, 0x1b, 0x80, 0x09, 0xe5, 0x34, 0x23, 0x82, 0x3a, 0x17, 0xe8, 0x5d, 0x80, 0xb4,
+	0x08, 0xe7, 0x2c, 0x0a, 0x9e, 0xd3, 0x95, 0xde, 0xba, 0x53, 0x92, 0x2f, 0xe9, 0x0a, 0x7d, 0x08,
+	0x47, 0x9b, 0xee, 0xca, 0xf3, 0x0a, 0xe4, 0x7b, 0x53, 0x57, 0x3b, 0xdd, 0xa9, 0xf2, 0x47, 0x94,
+	0x5a, 0xbf, 0x18, 0xd0, 0x76, 0x09, 0x4f, 0x2e, 0xc9, 0xea, 0x2d, 0xc3, 0x1c, 0x42, 0x9d, 0xc5,
+	0x2a, 0x48, 0x13, 0xd7, 0x59, 0x5c, 0x09, 0xd7, 0x7c, 0x2d, 0xdc, 0x03, 0x38, 0x14, 0x4b, 0x1e,
+	0xd0, 0x65, 0xca, 0x5e, 0x6b, 0xeb, 0x81, 0x58, 0x72, 0x7b, 0x0b, 0xd1, 0x00, 0xee, 0x71, 0xc2,
+	0x93, 0x20, 0x25, 0xab, 0xea, 0xda, 0x96, 0x5a, 0x7b, 0x97, 0x97, 0x56, 0x77, 0xeb, 0xad, 0x1f,
+	0x0c, 0x00, 0x27, 0xcf, 0x0b, 0xfa, 0xe6, 0x86, 0xfc, 0xcd, 0x15, 0x57, 0x6b, 0x57, 0x8b, 0x30,
+	0x99, 0xeb, 0x26, 0x6b, 0x85, 0xde, 0x83, 0x9e, 0x48, 0x04, 0x99, 0x07, 0x79, 0x91, 0xa6, 0xf3,
+	0x95, 0xce, 0xd1, 0x55, 0xcc, 0x53, 0x48, 0x3d, 0x85, 0xf2, 0x08, 0xf2, 0x5d, 0x8e, 0x1d, 0xf8,
+	0xe8, 0x45, 0x1d, 0xba, 0x95, 0x07, 0x0f, 0xdd, 0x85, 0x83, 0xf1, 0x63, 0xc7, 0x1d, 0x0d, 0x3d,
+	0x3b, 0xf0, 0x9f, 0x5e, 0xda, 0x47, 0x35, 0xf4, 0x3f, 0xb8, 0xe7, 0xe3, 0xa1, 0xeb, 0x3d, 0xb2,
+	0x71, 0x30, 0xf4, 0x3c, 0xdb, 0x2f, 0x27, 0x0c, 0x74, 0x1f, 0x90, 0xe7, 0x4c, 0x82, 0xf1, 0x17,
+	0x43, 0xc7, 0x0d, 0xfc, 0x27, 0x6e, 0xc9, 0xeb, 0x92, 0x63, 0x7b, 0xe2, 0x78, 0xbe, 0x8d, 0x03,
+	0x77, 0xf8, 0xb5, 0x2e, 0xd4, 0x90, 0x7c, 0x5b, 0x68, 0xc7, 0x9b, 0xe8, 0x18, 0x8e, 0x2e, 0xec,
+	0xaf, 0x6c, 0xdf, 0xae, 0xd0, 0x3d, 0x84, 0xe0, 0xd0, 0x9b, 0x8e, 0xbc, 0x31, 0x76, 0x46, 0x9a,
+	0xb5, 0xe4, 0xca, 0xa9, 0xfb, 0x17, 0xda, 0x96, 0x74, 0x62, 0xbb, 0x36, 0x1e, 0xfa, 0x76, 0xe0,
+	0x5c, 0x94, 0x74, 0x5f, 0x26, 0x71, 0x87, 0xee, 0xe3, 0xe0, 0x72, 0xf8, 0xb4, 0x44, 0x1d, 0xb9,
+	0xd0, 0xf1, 0xbc, 0xa9, 0x5d, 0x8d, 0x01, 0xa3, 0xcf, 0xae, 0x6f, 0xcc, 0xda, 0xcb, 0x1b, 0xb3,
+	0xf6, 0xea, 0xc6, 0x34, 0xfe, 0xb8, 0x31, 0x8d, 0x17, 0x6b, 0xd3, 0xf8, 0x71, 0x6d, 0x1a, 0x3f,
+	0xaf, 0x4d, 0xe3, 0xd7, 0xb5, 0x69, 0x5c, 0xaf, 0x4d, 0xe3, 0xb7, 0xb5, 0x69, 0xfc, 0xbe, 0x36,
+	0x6b, 0xaf, 0xd6, 0xa6, 0xf1, 0xfd, 0xad, 0x59, 0xbb, 0xbe, 0x35, 0x6b, 0x2f, 0x6f, 0xcd, 0x5a,
+	0xd8, 0x52, 0xff, 0xba, 0x9f, 0xfc, 0x39, 0x00, 0xba, 0x34, 0x1b, 0xbe, 0xc0, 0x07, 0x00, 0x00,
 }
 
 func (x PayloadType) String() string {
@@ -1208,6 +1277,36 @@ func (this *RegisterName) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TransferName) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransferName)
+	if !ok {
+		that2, ok := that.(TransferName)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Registrant, that1.Registrant) {
+		return false
+	}
+	if !bytes.Equal(this.Recipient, that1.Recipient) {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	return true
+}
 func (this *DeleteName) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1332,6 +1431,9 @@ func (this *TransferAsset) Equal(that interface{}) bool {
 	if this.Amount != that1.Amount {
 		return false
 	}
+	if !bytes.Equal(this.AssetId, that1.AssetId) {
+		return false
+	}
 	return true
 }
 func (this *GenerateID) Equal(that interface{}) bool {
@@ -1522,6 +1624,18 @@ func (this *RegisterName) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *TransferName) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&pb.TransferName{")
+	s = append(s, "Registrant: "+fmt.Sprintf("%#v", this.Registrant)+",\n")
+	s = append(s, "Recipient: "+fmt.Sprintf("%#v", this.Recipient)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *DeleteName) GoString() string {
 	if this == nil {
 		return "nil"
@@ -1564,11 +1678,12 @@ func (this *TransferAsset) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 8)
 	s = append(s, "&pb.TransferAsset{")
 	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
 	s = append(s, "Recipient: "+fmt.Sprintf("%#v", this.Recipient)+",\n")
 	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
+	s = append(s, "AssetId: "+fmt.Sprintf("%#v", this.AssetId)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1858,6 +1973,42 @@ func (m *RegisterName) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *TransferName) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferName) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Registrant) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Registrant)))
+		i += copy(dAtA[i:], m.Registrant)
+	}
+	if len(m.Recipient) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Recipient)))
+		i += copy(dAtA[i:], m.Recipient)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	return i, nil
+}
+
 func (m *DeleteName) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2008,6 +2159,12 @@ func (m *TransferAsset) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintTransaction(dAtA, i, uint64(m.Amount))
 	}
+	if len(m.AssetId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.AssetId)))
+		i += copy(dAtA[i:], m.AssetId)
+	}
 	return i, nil
 }
 
@@ -2263,13 +2420,31 @@ func NewPopulatedRegisterName(r randyTransaction, easy bool) *RegisterName {
 	return this
 }
 
-func NewPopulatedDeleteName(r randyTransaction, easy bool) *DeleteName {
-	this := &DeleteName{}
+func NewPopulatedTransferName(r randyTransaction, easy bool) *TransferName {
+	this := &TransferName{}
 	v11 := r.Intn(100)
 	this.Registrant = make([]byte, v11)
 	for i := 0; i < v11; i++ {
 		this.Registrant[i] = byte(r.Intn(256))
 	}
+	v12 := r.Intn(100)
+	this.Recipient = make([]byte, v12)
+	for i := 0; i < v12; i++ {
+		this.Recipient[i] = byte(r.Intn(256))
+	}
+	this.Name = string(randStringTransaction(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDeleteName(r randyTransaction, easy bool) *DeleteName {
+	this := &DeleteName{}
+	v13 := r.Intn(100)
+	this.Registrant = make([]byte, v13)
+	for i := 0; i < v13; i++ {
+		this.Registrant[i] = byte(r.Intn(256))
+	}
 	this.Name = string(randStringTransaction(r))
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -2278,9 +2453,9 @@ func NewPopulatedDeleteName(r randyTransaction, easy bool) *DeleteName {
 
 func NewPopulatedSubscribe(r randyTransaction, easy bool) *Subscribe {
 	this := &Subscribe{}
-	v12 := r.Intn(100)
-	this.Subscriber = make([]byte, v12)
-	for i := 0; i < v12; i++ {
+	v14 := r.Intn(100)
+	this.Subscriber = make([]byte, v14)
+	for i := 0; i < v14; i++ {
 		this.Subscriber[i] = byte(r.Intn(256))
 	}
 	this.Identifier = string(randStringTransaction(r))
@@ -2295,9 +2470,9 @@ func NewPopulatedSubscribe(r randyTransaction, easy bool) *Subscribe {
 
 func NewPopulatedUnsubscribe(r randyTransaction, easy bool) *Unsubscribe {
 	this := &Unsubscribe{}
-	v13 := r.Intn(100)
-	this.Subscriber = make([]byte, v13)
-	for i := 0; i < v13; i++ {
+	v15 := r.Intn(100)
+	this.Subscriber = make([]byte, v15)
+	for i := 0; i < v15; i++ {
 		this.Subscriber[i] = byte(r.Intn(256))
 	}
 	this.Identifier = string(randStringTransaction(r))
@@ -2309,20 +2484,25 @@ func NewPopulatedUnsubscribe(r randyTransaction, easy bool) *Unsubscribe {
 
 func NewPopulatedTransferAsset(r randyTransaction, easy bool) *TransferAsset {
 	this := &TransferAsset{}
-	v14 := r.Intn(100)
-	this.Sender = make([]byte, v14)
-	for i := 0; i < v14; i++ {
+	v16 := r.Intn(100)
+	this.Sender = make([]byte, v16)
+	for i := 0; i < v16; i++ {
 		this.Sender[i] = byte(r.Intn(256))
 	}
-	v15 := r.Intn(100)
-	this.Recipient = make([]byte, v15)
-	for i := 0; i < v15; i++ {
+	v17 := r.Intn(100)
+	this.Recipient = make([]byte, v17)
+	for i := 0; i < v17; i++ {
 		this.Recipient[i] = byte(r.Intn(256))
 	}
 	this.Amount = int64(r.Int63())
 	if r.Intn(2) == 0 {
 		this.Amount *= -1
 	}
+	v18 := r.Intn(100)
+	this.AssetId = make([]byte, v18)
+	for i := 0; i < v18; i++ {
+		this.AssetId[i] = byte(r.Intn(256))
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2330,9 +2510,9 @@ func NewPopulatedTransferAsset(r randyTransaction, easy bool) *TransferAsset {
 
 func NewPopulatedGenerateID(r randyTransaction, easy bool) *GenerateID {
 	this := &GenerateID{}
-	v16 := r.Intn(100)
-	this.PublicKey = make([]byte, v16)
-	for i := 0; i < v16; i++ {
+	v19 := r.Intn(100)
+	this.PublicKey = make([]byte, v19)
+	for i := 0; i < v19; i++ {
 		this.PublicKey[i] = byte(r.Intn(256))
 	}
 	this.RegistrationFee = int64(r.Int63())
@@ -2346,14 +2526,14 @@ func NewPopulatedGenerateID(r randyTransaction, easy bool) *GenerateID {
 
 func NewPopulatedNanoPay(r randyTransaction, easy bool) *NanoPay {
 	this := &NanoPay{}
-	v17 := r.Intn(100)
-	this.Sender = make([]byte, v17)
-	for i := 0; i < v17; i++ {
+	v20 := r.Intn(100)
+	this.Sender = make([]byte, v20)
+	for i := 0; i < v20; i++ {
 		this.Sender[i] = byte(r.Intn(256))
 	}
-	v18 := r.Intn(100)
-	this.Recipient = make([]byte, v18)
-	for i := 0; i < v18; i++ {
+	v21 := r.Intn(100)
+	this.Recipient = make([]byte, v21)
+	for i := 0; i < v21; i++ {
 		this.Recipient[i] = byte(r.Intn(256))
 	}
 	this.Id = uint64(uint64(r.Uint32()))
@@ -2370,9 +2550,9 @@ func NewPopulatedNanoPay(r randyTransaction, easy bool) *NanoPay {
 
 func NewPopulatedIssueAsset(r randyTransaction, easy bool) *IssueAsset {
 	this := &IssueAsset{}
-	v19 := r.Intn(100)
-	this.Sender = make([]byte, v19)
-	for i := 0; i < v19; i++ {
+	v22 := r.Intn(100)
+	this.Sender = make([]byte, v22)
+	for i := 0; i < v22; i++ {
 		this.Sender[i] = byte(r.Intn(256))
 	}
 	this.Name = string(randStringTransaction(r))
@@ -2406,9 +2586,9 @@ func randUTF8RuneTransaction(r randyTransaction) rune {
 	return rune(ru + 61)
 }
 func randStringTransaction(r randyTransaction) string {
-	v20 := r.Intn(100)
-	tmps := make([]rune, v20)
-	for i := 0; i < v20; i++ {
+	v23 := r.Intn(100)
+	tmps := make([]rune, v23)
+	for i := 0; i < v23; i++ {
 		tmps[i] = randUTF8RuneTransaction(r)
 	}
 	return string(tmps)
@@ -2430,11 +2610,11 @@ func randFieldTransaction(dAtA []byte, r randyTransaction, fieldNumber int, wire
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateTransaction(dAtA, uint64(key))
-		v21 := r.Int63()
+		v24 := r.Int63()
 		if r.Intn(2) == 0 {
-			v21 *= -1
+			v24 *= -1
 		}
-		dAtA = encodeVarintPopulateTransaction(dAtA, uint64(v21))
+		dAtA = encodeVarintPopulateTransaction(dAtA, uint64(v24))
 	case 1:
 		dAtA = encodeVarintPopulateTransaction(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -2588,6 +2768,27 @@ func (m *RegisterName) Size() (n int) {
 	return n
 }
 
+func (m *TransferName) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Registrant)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	return n
+}
+
 func (m *DeleteName) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2674,6 +2875,10 @@ func (m *TransferAsset) Size() (n int) {
 	if m.Amount != 0 {
 		n += 1 + sovTransaction(uint64(m.Amount))
 	}
+	l = len(m.AssetId)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
 	return n
 }
 
@@ -2847,6 +3052,18 @@ func (this *RegisterName) String() string {
 	}, "")
 	return s
 }
+func (this *TransferName) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransferName{`,
+		`Registrant:` + fmt.Sprintf("%v", this.Registrant) + `,`,
+		`Recipient:` + fmt.Sprintf("%v", this.Recipient) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *DeleteName) String() string {
 	if this == nil {
 		return "nil"
@@ -2893,6 +3110,7 @@ func (this *TransferAsset) String() string {
 		`Sender:` + fmt.Sprintf("%v", this.Sender) + `,`,
 		`Recipient:` + fmt.Sprintf("%v", this.Recipient) + `,`,
 		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
+		`AssetId:` + fmt.Sprintf("%v", this.AssetId) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3836,6 +4054,159 @@ func (m *RegisterName) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TransferName) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferName: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferName: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registrant", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Registrant = append(m.Registrant[:0], dAtA[iNdEx:postIndex]...)
+			if m.Registrant == nil {
+				m.Registrant = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DeleteName) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4443,6 +4814,40 @@ func (m *TransferAsset) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetId = append(m.AssetId[:0], dAtA[iNdEx:postIndex]...)
+			if m.AssetId == nil {
+				m.AssetId = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransaction(dAtA[iNdEx:])
@@ -31,6 +31,8 @@ func Unpack(payload *pb.Payload) (IPayload, error) {
 		pl = new(pb.SigChainTxn)
 	case pb.REGISTER_NAME_TYPE:
 		pl = new(pb.RegisterName)
+	case pb.TRANSFER_NAME_TYPE:
+		pl = new(pb.TransferName)
 	case pb.DELETE_NAME_TYPE:
 		pl = new(pb.DeleteName)
 	case pb.SUBSCRIBE_TYPE:
@@ -67,6 +69,15 @@ func NewTransferAsset(sender, recipient common.Uint160, amount common.Fixed64) I
 	}
 }
 
+func NewTransferAssetWithID(sender, recipient common.Uint160, assetID common.Uint256, amount common.Fixed64) IPayload {
+	return &pb.TransferAsset{
+		Sender:    sender.ToArray(),
+		Recipient: recipient.ToArray(),
+		Amount:    int64(amount),
+		AssetId:   assetID.ToArray(),
+	}
+}
+
 func NewSigChainTxn(sigChain []byte, submitter common.Uint160) IPayload {
 	return &pb.SigChainTxn{
 		SigChain:  sigChain,
@@ -80,6 +91,14 @@ func NewRegisterName(registrant []byte, name string) IPayload {
 	}
 }
 
+func NewTransferName(registrant, recipient []byte, name string) IPayload {
+	return &pb.TransferName{
+		Registrant: registrant,
+		Recipient:  recipient,
+		Name:       name,
+	}
+}
+
 func NewDeleteName(registrant []byte, name string) IPayload {
 	return &pb.DeleteName{
 		Registrant: registrant,
@@ -77,6 +77,16 @@ const (
 	defaultTxPoolMaxMemorySize    = 32
 )
 
+const (
+	// SyncModeFull syncs and executes all blocks from genesis block
+	SyncModeFull = "full"
+	// SyncModeSnapshot syncs states at a recent block instead of executing all
+	// blocks. Ledger synced in this mode is not an archival ledger: blocks
+	// before the snapshot are not available, and requests for them return an
+	// error.
+	SyncModeSnapshot = "snapshot"
+)
+
 var (
 	Debug            = false
 	StatePruning     = false
@@ -130,6 +140,18 @@ var (
 		heights: []uint32{7500, 0},
 		values:  []bool{false, true},
 	}
+	AllowTxnTransferName = HeightDependentBool{
+		heights: []uint32{300000, 0},
+		values:  []bool{true, false},
+	}
+	AllowTransferAssetID = HeightDependentBool{
+		heights: []uint32{300000, 0},
+		values:  []bool{true, false},
+	}
+	RequireWsClientAuth = HeightDependentBool{
+		heights: []uint32{2500000, 0},
+		values:  []bool{true, false},
+	}
 )
 
 var (
@@ -169,7 +191,13 @@ var (
 		NumTxnPerBlock:               256,
 		TxPoolPerAccountTxCap:        32,
 		TxPoolTotalTxCap:             0,
+		TxPoolJournal:                false,
+		TxPoolJournalPath:            "TxPoolJournal",
+		TxStatusRetention:            3600,
+		TxStatusMaxTxns:              65536,
 		TxPoolMaxMemorySize:          0,
+		TxPoolReplaceFeeBump:         10,
+		TxPoolFeeThreshold:           50,
 		RegisterIDRegFee:             0,
 		RegisterIDTxnFee:             0,
 		LogPath:                      "Log",
@@ -186,6 +214,23 @@ var (
 		WebGuiCreateWallet:           false,
 		PasswordFile:                 "",
 		RecentStateCount:             1000,
+		AddressTxIndex:               false,
+		SyncMode:                     SyncModeFull,
+		MsgBufferMaxMsgsPerClient:    1024,
+		MsgBufferMaxSizePerClient:    4 << 20,
+		MsgBufferMaxSizePerSource:    32 << 20,
+		MsgBufferMaxTotalSize:        256,
+		MsgBufferPersistent:          false,
+		MsgBufferDBPath:              "MessageBufferDB",
+		MaxPublishDests:              100,
+		RelayMaxPayloadSize:          4 << 20,
+		RelayClientMaxMsgsPerSecond:  100,
+		RelayClientMaxBytesPerSecond: 1 << 20,
+		RelayIPMaxMsgsPerSecond:      500,
+		RelayIPMaxBytesPerSecond:     5 << 20,
+		RelayFragmentSize:            1 << 20,
+		RelayFragmentTimeout:         60,
+		WsResumeGracePeriod:          30,
 	}
 )
 
@@ -224,7 +269,13 @@ type Configuration struct {
 	NumTxnPerBlock               uint32        `json:"NumTxnPerBlock"`
 	TxPoolPerAccountTxCap        uint32        `json:"TxPoolPerAccountTxCap"`
 	TxPoolTotalTxCap             uint32        `json:"TxPoolTotalTxCap"`
+	TxPoolJournal                bool          `json:"TxPoolJournal"`
+	TxPoolJournalPath            string        `json:"TxPoolJournalPath"`
+	TxStatusRetention            time.Duration `json:"TxStatusRetention"`     // in seconds
+	TxStatusMaxTxns              uint32        `json:"TxStatusMaxTxns"`       // max number of txns tracked
 	TxPoolMaxMemorySize          uint32        `json:"TxPoolMaxMemorySize"`   // in megabytes (MB)
+	TxPoolReplaceFeeBump         uint32        `json:"TxPoolReplaceFeeBump"`  // in percentage
+	TxPoolFeeThreshold           uint32        `json:"TxPoolFeeThreshold"`    // in percentage
 	RPCReadTimeout               time.Duration `json:"RPCReadTimeout"`        // in seconds
 	RPCWriteTimeout              time.Duration `json:"RPCWriteTimeout"`       // in seconds
 	KeepAliveTimeout             time.Duration `json:"KeepAliveTimeout"`      // in seconds
@@ -240,6 +291,25 @@ type Configuration struct {
 	WebGuiCreateWallet           bool          `json:"WebGuiCreateWallet"`
 	PasswordFile                 string        `json:"PasswordFile"`
 	RecentStateCount             uint32        `json:"RecentStateCount"`
+	AddressTxIndex               bool          `json:"AddressTxIndex"`
+	SyncMode                     string        `json:"SyncMode"` // full or snapshot
+	WsClientAuthRequired         bool          `json:"WsClientAuthRequired"`
+	MsgBufferMaxMsgsPerClient    uint32        `json:"MsgBufferMaxMsgsPerClient"`
+	MsgBufferMaxSizePerClient    uint32        `json:"MsgBufferMaxSizePerClient"` // in bytes
+	MsgBufferMaxSizePerSource    uint32        `json:"MsgBufferMaxSizePerSource"` // in bytes
+	MsgBufferMaxTotalSize        uint32        `json:"MsgBufferMaxTotalSize"`     // in megabytes (MB)
+	MsgBufferPersistent          bool          `json:"MsgBufferPersistent"`
+	MsgBufferDBPath              string        `json:"MsgBufferDBPath"`
+	MaxPublishDests              uint32        `json:"MaxPublishDests"`     // per outbound message
+	RelayMaxPayloadSize          uint32        `json:"RelayMaxPayloadSize"` // in bytes
+	RelayClientMaxMsgsPerSecond  uint32        `json:"RelayClientMaxMsgsPerSecond"`
+	RelayClientMaxBytesPerSecond uint32        `json:"RelayClientMaxBytesPerSecond"`
+	RelayIPMaxMsgsPerSecond      uint32        `json:"RelayIPMaxMsgsPerSecond"`
+	RelayIPMaxBytesPerSecond     uint32        `json:"RelayIPMaxBytesPerSecond"`
+	AccessListFile               string        `json:"AccessListFile"`
+	RelayFragmentSize            uint32        `json:"RelayFragmentSize"`    // in bytes
+	RelayFragmentTimeout         time.Duration `json:"RelayFragmentTimeout"` // in seconds
+	WsResumeGracePeriod          time.Duration `json:"WsResumeGracePeriod"`  // in seconds
 }
 
 func Init() error {
@@ -439,6 +509,14 @@ func (config *Configuration) verify() error {
 		return fmt.Errorf("MaxLogFileSize should be >= 1 (MB)")
 	}
 
+	if config.SyncMode != SyncModeFull && config.SyncMode != SyncModeSnapshot {
+		return fmt.Errorf("unknown SyncMode %s, should be %s or %s", config.SyncMode, SyncModeFull, SyncModeSnapshot)
+	}
+
+	if config.MsgBufferPersistent && len(config.MsgBufferDBPath) == 0 {
+		return errors.New("MsgBufferDBPath should not be empty when MsgBufferPersistent is true")
+	}
+
 	return nil
 }
 
@@ -0,0 +1,223 @@
+package ratelimit
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	usageWindow     = time.Minute
+	idleTimeout     = 10 * time.Minute
+	cleanupInterval = time.Minute
+)
+
+var (
+	ErrMsgRateExceeded   = errors.New("message rate limit exceeded")
+	ErrByteRateExceeded  = errors.New("byte rate limit exceeded")
+	ErrMsgBurstExceeded  = errors.New("number of messages exceeds rate limit burst")
+	ErrByteBurstExceeded = errors.New("number of bytes exceeds rate limit burst")
+)
+
+// Usage is the usage of a key
+type Usage struct {
+	NumMsgs        uint64  `json:"numMsgs"`
+	NumBytes       uint64  `json:"numBytes"`
+	NumRejected    uint64  `json:"numRejected"`
+	MsgsPerSecond  float64 `json:"msgsPerSecond"`
+	BytesPerSecond float64 `json:"bytesPerSecond"`
+	LastActive     int64   `json:"lastActive"`
+}
+
+// tokenBucket allows burst of up to burst tokens and refills at rate tokens
+// per second. A request larger than the burst is never allowed.
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate, burst float64, now time.Time) *tokenBucket {
+	if burst < rate {
+		burst = rate
+	}
+	return &tokenBucket{rate: rate, burst: burst, tokens: burst, last: now}
+}
+
+// exceedsBurst returns whether n tokens can never be allowed
+func (tb *tokenBucket) exceedsBurst(n float64) bool {
+	return tb.rate > 0 && n > tb.burst
+}
+
+func (tb *tokenBucket) allow(n float64, now time.Time) bool {
+	if tb.rate <= 0 {
+		return true
+	}
+	tb.tokens += now.Sub(tb.last).Seconds() * tb.rate
+	if tb.tokens > tb.burst {
+		tb.tokens = tb.burst
+	}
+	tb.last = now
+	return tb.tokens >= n
+}
+
+type entry struct {
+	msgBucket    *tokenBucket
+	byteBucket   *tokenBucket
+	usage        Usage
+	windowStart  time.Time
+	windowMsgs   uint64
+	windowBytes  uint64
+	lastActiveAt time.Time
+}
+
+// RateLimiter limits number of messages and bytes per second of each key
+type RateLimiter struct {
+	sync.Mutex
+	msgsPerSecond  float64
+	bytesPerSecond float64
+	msgBurst       float64
+	byteBurst      float64
+	entries        map[string]*entry
+}
+
+// NewRateLimiter creates a RateLimiter with burst of one second of rate. Limit
+// that is not greater than 0 means unlimited.
+func NewRateLimiter(msgsPerSecond, bytesPerSecond float64) *RateLimiter {
+	return NewRateLimiterWithBurst(msgsPerSecond, bytesPerSecond, msgsPerSecond, bytesPerSecond)
+}
+
+// NewRateLimiterWithBurst creates a RateLimiter with the max number of
+// messages and bytes allowed at once. Burst less than rate is raised to rate.
+func NewRateLimiterWithBurst(msgsPerSecond, bytesPerSecond, msgBurst, byteBurst float64) *RateLimiter {
+	rl := &RateLimiter{
+		msgsPerSecond:  msgsPerSecond,
+		bytesPerSecond: bytesPerSecond,
+		msgBurst:       msgBurst,
+		byteBurst:      byteBurst,
+		entries:        make(map[string]*entry),
+	}
+	go rl.cleanup()
+	return rl
+}
+
+// Allow checks and consumes quota of key for numMsgs messages with numBytes
+// bytes in total. Returns nil if allowed.
+func (rl *RateLimiter) Allow(key string, numMsgs, numBytes int) error {
+	return rl.allow(key, numMsgs, numBytes, time.Now())
+}
+
+// Check checks quota of key for numMsgs messages with numBytes bytes in total
+// without consuming it. Returns nil if allowed.
+func (rl *RateLimiter) Check(key string, numMsgs, numBytes int) error {
+	return rl.check(key, numMsgs, numBytes, time.Now())
+}
+
+func (rl *RateLimiter) allow(key string, numMsgs, numBytes int, now time.Time) error {
+	rl.Lock()
+	defer rl.Unlock()
+
+	e := rl.getOrNewEntry(key, now)
+	if err := e.check(numMsgs, numBytes, now); err != nil {
+		return err
+	}
+	e.consume(numMsgs, numBytes)
+
+	return nil
+}
+
+func (rl *RateLimiter) check(key string, numMsgs, numBytes int, now time.Time) error {
+	rl.Lock()
+	defer rl.Unlock()
+
+	return rl.getOrNewEntry(key, now).check(numMsgs, numBytes, now)
+}
+
+// getOrNewEntry returns the entry of key and updates its usage window. Caller
+// should hold the lock.
+func (rl *RateLimiter) getOrNewEntry(key string, now time.Time) *entry {
+	e, ok := rl.entries[key]
+	if !ok {
+		e = &entry{
+			msgBucket:   newTokenBucket(rl.msgsPerSecond, rl.msgBurst, now),
+			byteBucket:  newTokenBucket(rl.bytesPerSecond, rl.byteBurst, now),
+			windowStart: now,
+		}
+		rl.entries[key] = e
+	}
+	e.lastActiveAt = now
+	e.usage.LastActive = now.Unix()
+
+	if now.Sub(e.windowStart) >= usageWindow {
+		seconds := now.Sub(e.windowStart).Seconds()
+		e.usage.MsgsPerSecond = float64(e.windowMsgs) / seconds
+		e.usage.BytesPerSecond = float64(e.windowBytes) / seconds
+		e.windowStart = now
+		e.windowMsgs = 0
+		e.windowBytes = 0
+	}
+
+	return e
+}
+
+func (e *entry) check(numMsgs, numBytes int, now time.Time) error {
+	if e.msgBucket.exceedsBurst(float64(numMsgs)) {
+		e.usage.NumRejected++
+		return ErrMsgBurstExceeded
+	}
+	if e.byteBucket.exceedsBurst(float64(numBytes)) {
+		e.usage.NumRejected++
+		return ErrByteBurstExceeded
+	}
+
+	if !e.msgBucket.allow(float64(numMsgs), now) {
+		e.usage.NumRejected++
+		return ErrMsgRateExceeded
+	}
+	if !e.byteBucket.allow(float64(numBytes), now) {
+		e.usage.NumRejected++
+		return ErrByteRateExceeded
+	}
+
+	return nil
+}
+
+func (e *entry) consume(numMsgs, numBytes int) {
+	if e.msgBucket.rate > 0 {
+		e.msgBucket.tokens -= float64(numMsgs)
+	}
+	if e.byteBucket.rate > 0 {
+		e.byteBucket.tokens -= float64(numBytes)
+	}
+
+	e.usage.NumMsgs += uint64(numMsgs)
+	e.usage.NumBytes += uint64(numBytes)
+	e.windowMsgs += uint64(numMsgs)
+	e.windowBytes += uint64(numBytes)
+}
+
+// GetUsage returns usage of all keys that are active recently
+func (rl *RateLimiter) GetUsage() map[string]Usage {
+	rl.Lock()
+	defer rl.Unlock()
+	usage := make(map[string]Usage, len(rl.entries))
+	for key, e := range rl.entries {
+		usage[key] = e.usage
+	}
+	return usage
+}
+
+func (rl *RateLimiter) cleanup() {
+	for {
+		time.Sleep(cleanupInterval)
+		rl.Lock()
+		now := time.Now()
+		for key, e := range rl.entries {
+			if now.Sub(e.lastActiveAt) > idleTimeout {
+				delete(rl.entries, key)
+			}
+		}
+		rl.Unlock()
+	}
+}
@@ -0,0 +1,84 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	rl := NewRateLimiterWithBurst(10, 100, 10, 200)
+	start := time.Now()
+
+	tests := []struct {
+		name     string
+		key      string
+		elapsed  time.Duration
+		numMsgs  int
+		numBytes int
+		err      error
+	}{
+		{"within burst", "a", 0, 1, 50, nil},
+		{"msgs over remaining tokens", "a", 0, 10, 10, ErrMsgRateExceeded},
+		{"msgs over burst", "a", 0, 11, 10, ErrMsgBurstExceeded},
+		{"bytes over burst", "a", 0, 1, 201, ErrByteBurstExceeded},
+		{"use remaining bytes", "a", 0, 1, 150, nil},
+		{"bytes over remaining tokens", "a", 0, 1, 1, ErrByteRateExceeded},
+		{"other key not affected", "b", 0, 10, 200, nil},
+		{"refill one second", "a", time.Second, 1, 100, nil},
+		{"refill capped at burst", "a", 10 * time.Second, 1, 200, nil},
+		{"bytes over burst after refill", "a", 20 * time.Second, 1, 201, ErrByteBurstExceeded},
+	}
+
+	for _, test := range tests {
+		if err := rl.allow(test.key, test.numMsgs, test.numBytes, start.Add(test.elapsed)); err != test.err {
+			t.Errorf("%s: expect %v, got %v", test.name, test.err, err)
+		}
+	}
+
+	usage := rl.GetUsage()["a"]
+	if usage.NumMsgs != 4 || usage.NumBytes != 500 || usage.NumRejected != 5 {
+		t.Errorf("unexpected usage %+v", usage)
+	}
+}
+
+func TestRateLimiterDefaultBurst(t *testing.T) {
+	rl := NewRateLimiter(100, 1000)
+	now := time.Now()
+
+	if err := rl.allow("a", 101, 0, now); err != ErrMsgBurstExceeded {
+		t.Errorf("expect %v for request larger than one second of rate, got %v", ErrMsgBurstExceeded, err)
+	}
+	if err := rl.allow("a", 1, 1001, now); err != ErrByteBurstExceeded {
+		t.Errorf("expect %v for request larger than one second of rate, got %v", ErrByteBurstExceeded, err)
+	}
+	if err := rl.allow("a", 100, 1000, now); err != nil {
+		t.Errorf("expect full burst to be allowed, got %v", err)
+	}
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	rl := NewRateLimiter(0, 0)
+	if err := rl.Allow("a", 1000000, 1<<30); err != nil {
+		t.Errorf("expect unlimited, got %v", err)
+	}
+}
+
+func TestRateLimiterCheck(t *testing.T) {
+	rl := NewRateLimiter(10, 100)
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		if err := rl.check("a", 10, 100, now); err != nil {
+			t.Errorf("expect check within burst to be allowed, got %v", err)
+		}
+	}
+	if err := rl.check("a", 1, 101, now); err != ErrByteBurstExceeded {
+		t.Errorf("expect %v, got %v", ErrByteBurstExceeded, err)
+	}
+	if usage := rl.GetUsage()["a"]; usage.NumMsgs != 0 || usage.NumBytes != 0 {
+		t.Errorf("expect check not to consume quota, got %+v", usage)
+	}
+	if err := rl.allow("a", 10, 100, now); err != nil {
+		t.Errorf("expect full burst to be allowed after check, got %v", err)
+	}
+}